@@ -0,0 +1,175 @@
+package null
+
+import (
+	"errors"
+	"math"
+)
+
+// vars
+var (
+	// ErrOverflow is returned by the checked arithmetic methods, such as
+	// Int8.AddChecked, for a result that does not fit the type.
+	ErrOverflow = errors.New("null: arithmetic overflow")
+	// ErrDivisionByZero is returned by DivChecked and ModChecked for a zero
+	// divisor.
+	ErrDivisionByZero = errors.New("null: division by zero")
+)
+
+// errNullResult makes binary and unary return null without an error.
+var errNullResult = errors.New("null result")
+
+type integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
+}
+
+type float interface {
+	~float32 | ~float64
+}
+
+type number interface {
+	integer | float
+}
+
+// binary applies op to x and y. The result is null if x or y is null, or if
+// op fails; the error of op is returned unless it is errNullResult.
+func binary[T number](x T, xValid bool, y T, yValid bool, op func(T, T) (T, error)) (T, bool, error) {
+	if !xValid || !yValid {
+		return 0, false, nil
+	}
+	z, err := op(x, y)
+	if err == errNullResult {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, err
+	}
+	return z, true, nil
+}
+
+// unary applies op to x, as binary.
+func unary[T number](x T, valid bool, op func(T) (T, error)) (T, bool, error) {
+	return binary(x, valid, 0, true, func(x, _ T) (T, error) { return op(x) })
+}
+
+func add[T number](x, y T) (T, error) { return x + y, nil }
+func sub[T number](x, y T) (T, error) { return x - y, nil }
+func mul[T number](x, y T) (T, error) { return x * y, nil }
+func neg[T number](x T) (T, error)    { return -x, nil }
+
+func div[T number](x, y T) (T, error) {
+	if y == 0 {
+		return 0, errNullResult
+	}
+	return x / y, nil
+}
+
+func mod[T integer](x, y T) (T, error) {
+	if y == 0 {
+		return 0, errNullResult
+	}
+	return x % y, nil
+}
+
+func abs[T number](x T) (T, error) {
+	if x < 0 {
+		return -x, nil
+	}
+	return x, nil
+}
+
+func addChecked[T integer](x, y T) (T, error) {
+	z := x + y
+	if y > 0 && z < x || y < 0 && z > x {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+func subChecked[T integer](x, y T) (T, error) {
+	z := x - y
+	if y > 0 && z > x || y < 0 && z < x {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+func mulChecked[T integer](x, y T) (T, error) {
+	if x == 0 || y == 0 {
+		return 0, nil
+	}
+	z := x * y
+	if z/y != x || (z < 0) != ((x < 0) != (y < 0)) {
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+func divChecked[T integer](x, y T) (T, error) {
+	if y == 0 {
+		return 0, ErrDivisionByZero
+	}
+	z := x / y
+	if x < 0 && y < 0 && z < 0 {
+		// The most negative value divided by -1.
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+func modChecked[T integer](x, y T) (T, error) {
+	if y == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return x % y, nil
+}
+
+func negChecked[T integer](x T) (T, error) {
+	z := -x
+	if x != 0 && (z < 0) == (x < 0) {
+		// The most negative value, or any unsigned value but zero.
+		return 0, ErrOverflow
+	}
+	return z, nil
+}
+
+func absChecked[T integer](x T) (T, error) {
+	if x < 0 {
+		return negChecked(x)
+	}
+	return x, nil
+}
+
+func modFloat[T float](x, y T) (T, error) {
+	if y == 0 {
+		return 0, errNullResult
+	}
+	return T(math.Mod(float64(x), float64(y))), nil
+}
+
+// finite makes op fail with ErrOverflow for an infinite result of finite
+// operands.
+func finite[T float](op func(T, T) (T, error)) func(T, T) (T, error) {
+	return func(x, y T) (T, error) {
+		z, err := op(x, y)
+		if err == nil && math.IsInf(float64(z), 0) &&
+			!math.IsInf(float64(x), 0) && !math.IsInf(float64(y), 0) {
+			return 0, ErrOverflow
+		}
+		return z, err
+	}
+}
+
+func divFloatChecked[T float](x, y T) (T, error) {
+	if y == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return finite(div[T])(x, y)
+}
+
+func modFloatChecked[T float](x, y T) (T, error) {
+	if y == 0 {
+		return 0, ErrDivisionByZero
+	}
+	return modFloat(x, y)
+}
@@ -0,0 +1,182 @@
+package null
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestArithNull(t *testing.T) {
+	one, null := Int64From(1), Int64{}
+	for name, got := range map[string]Int64{
+		"Add": one.Add(null), "Sub": null.Sub(one), "Mul": one.Mul(null),
+		"Div": null.Div(one), "Mod": one.Mod(null), "Neg": null.Neg(), "Abs": null.Abs(),
+	} {
+		if got.Valid {
+			t.Errorf("%s with null = %+v, want null", name, got)
+		}
+	}
+	if got, err := one.AddChecked(null); got.Valid || err != nil {
+		t.Errorf("AddChecked with null = %+v, %v", got, err)
+	}
+	if got, err := null.DivChecked(Int64From(0)); got.Valid || err != nil {
+		t.Errorf("DivChecked of null by zero = %+v, %v", got, err)
+	}
+}
+
+func TestArithInt(t *testing.T) {
+	if got := Int64From(7).Add(Int64From(3)); got != Int64From(10) {
+		t.Errorf("Add = %+v", got)
+	}
+	if got := IntFrom(7).Sub(IntFrom(10)); got != IntFrom(-3) {
+		t.Errorf("Sub = %+v", got)
+	}
+	if got := Int32From(-7).Mul(Int32From(3)); got != Int32From(-21) {
+		t.Errorf("Mul = %+v", got)
+	}
+	if got := Int16From(-7).Div(Int16From(2)); got != Int16From(-3) {
+		t.Errorf("Div = %+v", got)
+	}
+	if got := Int16From(-7).Mod(Int16From(2)); got != Int16From(-1) {
+		t.Errorf("Mod = %+v", got)
+	}
+	if got := Int64From(7).Div(Int64From(0)); got.Valid {
+		t.Errorf("Div by zero = %+v, want null", got)
+	}
+	if got := Int64From(7).Mod(Int64From(0)); got.Valid {
+		t.Errorf("Mod by zero = %+v, want null", got)
+	}
+	if got := Int8From(-5).Abs(); got != Int8From(5) {
+		t.Errorf("Abs = %+v", got)
+	}
+	if got := Int8From(5).Neg(); got != Int8From(-5) {
+		t.Errorf("Neg = %+v", got)
+	}
+	if got := Int8From(127).Add(Int8From(1)); got != Int8From(-128) {
+		t.Errorf("Add wraps = %+v, want -128", got)
+	}
+
+	if _, err := Int8From(127).AddChecked(Int8From(1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("AddChecked err = %v, want ErrOverflow", err)
+	}
+	if _, err := Int64From(math.MinInt64).DivChecked(Int64From(-1)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("DivChecked err = %v, want ErrOverflow", err)
+	}
+	if _, err := Int64From(1).DivChecked(Int64From(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivChecked err = %v, want ErrDivisionByZero", err)
+	}
+	if _, err := IntFrom(1).ModChecked(IntFrom(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("ModChecked err = %v, want ErrDivisionByZero", err)
+	}
+	if _, err := Int32From(math.MinInt32).AbsChecked(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("AbsChecked err = %v, want ErrOverflow", err)
+	}
+	if got, err := Int64From(math.MaxInt64).MulChecked(Int64From(1)); err != nil || got != Int64From(math.MaxInt64) {
+		t.Errorf("MulChecked = %+v, %v", got, err)
+	}
+}
+
+func TestArithUint(t *testing.T) {
+	if got := Uint8From(250).Add(Uint8From(10)); got != Uint8From(4) {
+		t.Errorf("Add wraps = %+v, want 4", got)
+	}
+	if got := UintFrom(3).Abs(); got != UintFrom(3) {
+		t.Errorf("Abs = %+v", got)
+	}
+	if _, err := Uint8From(250).AddChecked(Uint8From(10)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("AddChecked err = %v, want ErrOverflow", err)
+	}
+	if _, err := Uint64From(1).SubChecked(Uint64From(2)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("SubChecked err = %v, want ErrOverflow", err)
+	}
+	if _, err := Uint32From(1).NegChecked(); !errors.Is(err, ErrOverflow) {
+		t.Errorf("NegChecked err = %v, want ErrOverflow", err)
+	}
+	if got, err := Uint16From(0).NegChecked(); err != nil || got != Uint16From(0) {
+		t.Errorf("NegChecked(0) = %+v, %v", got, err)
+	}
+}
+
+func TestArithFloat(t *testing.T) {
+	if got := Float64From(7).Div(Float64From(2)); got != Float64From(3.5) {
+		t.Errorf("Div = %+v", got)
+	}
+	if got := Float64From(7).Mod(Float64From(2)); got != Float64From(1) {
+		t.Errorf("Mod = %+v", got)
+	}
+	if got := Float32From(1).Div(Float32From(0)); got.Valid {
+		t.Errorf("Div by zero = %+v, want null", got)
+	}
+	if got := Float32From(-1.5).Abs(); got != Float32From(1.5) {
+		t.Errorf("Abs = %+v", got)
+	}
+	if got := Float32From(math.MaxFloat32).Mul(Float32From(2)); !math.IsInf(float64(got.Float32), 1) {
+		t.Errorf("Mul = %+v, want +Inf", got)
+	}
+	if _, err := Float32From(math.MaxFloat32).MulChecked(Float32From(2)); !errors.Is(err, ErrOverflow) {
+		t.Errorf("MulChecked err = %v, want ErrOverflow", err)
+	}
+	if got, err := Float64From(math.Inf(1)).AddChecked(Float64From(1)); err != nil || !math.IsInf(got.Float64, 1) {
+		t.Errorf("AddChecked(+Inf, 1) = %+v, %v", got, err)
+	}
+	if _, err := Float64From(1).DivChecked(Float64From(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("DivChecked err = %v, want ErrDivisionByZero", err)
+	}
+	if _, err := Float64From(1).ModChecked(Float64From(0)); !errors.Is(err, ErrDivisionByZero) {
+		t.Errorf("ModChecked err = %v, want ErrDivisionByZero", err)
+	}
+}
+
+func TestArithCheckedExhaustive(t *testing.T) {
+	type op struct {
+		name  string
+		i8    func(x, y int8) (int8, error)
+		u8    func(x, y uint8) (uint8, error)
+		exact func(x, y int) (int, bool)
+	}
+	ops := []op{
+		{"add", addChecked[int8], addChecked[uint8], func(x, y int) (int, bool) { return x + y, true }},
+		{"sub", subChecked[int8], subChecked[uint8], func(x, y int) (int, bool) { return x - y, true }},
+		{"mul", mulChecked[int8], mulChecked[uint8], func(x, y int) (int, bool) { return x * y, true }},
+		{"div", divChecked[int8], divChecked[uint8], func(x, y int) (int, bool) {
+			if y == 0 {
+				return 0, false
+			}
+			return x / y, true
+		}},
+	}
+	for _, o := range ops {
+		for x := math.MinInt8; x <= math.MaxInt8; x++ {
+			for y := math.MinInt8; y <= math.MaxInt8; y++ {
+				want, ok := o.exact(x, y)
+				got, err := o.i8(int8(x), int8(y))
+				if ok && want >= math.MinInt8 && want <= math.MaxInt8 {
+					if err != nil || int(got) != want {
+						t.Fatalf("%s(%d, %d) int8 = %d, %v, want %d", o.name, x, y, got, err, want)
+					}
+				} else if err == nil {
+					t.Fatalf("%s(%d, %d) int8 = %d, want error", o.name, x, y, got)
+				}
+			}
+		}
+		for x := 0; x <= math.MaxUint8; x++ {
+			for y := 0; y <= math.MaxUint8; y++ {
+				want, ok := o.exact(x, y)
+				got, err := o.u8(uint8(x), uint8(y))
+				if ok && want >= 0 && want <= math.MaxUint8 {
+					if err != nil || int(got) != want {
+						t.Fatalf("%s(%d, %d) uint8 = %d, %v, want %d", o.name, x, y, got, err, want)
+					}
+				} else if err == nil {
+					t.Fatalf("%s(%d, %d) uint8 = %d, want error", o.name, x, y, got)
+				}
+			}
+		}
+	}
+	for x := math.MinInt8; x <= math.MaxInt8; x++ {
+		_, err := negChecked(int8(x))
+		if (err != nil) != (x == math.MinInt8) {
+			t.Fatalf("negChecked(%d) err = %v", x, err)
+		}
+	}
+}
@@ -109,6 +109,23 @@ func (b Bool) Ptr() *bool {
 	return &b.Bool
 }
 
+// ValueOr returns this Bool's value, or def if this Bool is null.
+func (b Bool) ValueOr(def bool) bool {
+	if !b.Valid {
+		return def
+	}
+	return b.Bool
+}
+
+// ValueOrZero returns this Bool's value, or the zero value of bool if this
+// Bool is null.
+func (b Bool) ValueOrZero() bool {
+	if !b.Valid {
+		return false
+	}
+	return b.Bool
+}
+
 // IsZero returns true for invalid Bools, for future omitempty support (Go 1.4?)
 func (b Bool) IsZero() bool {
 	return !b.Valid
@@ -142,3 +159,91 @@ func (b *Bool) Randomize(nextInt func() int64, fieldType string, shouldBeNull bo
 		b.Valid = true
 	}
 }
+
+// IsTrue returns true if b is true, and false if it is false or null.
+func (b Bool) IsTrue() bool {
+	return b.Valid && b.Bool
+}
+
+// IsFalse returns true if b is false, and false if it is true or null.
+func (b Bool) IsFalse() bool {
+	return b.Valid && !b.Bool
+}
+
+// IsUnknown returns true if b is null, which SQL calls unknown.
+func (b Bool) IsUnknown() bool {
+	return !b.Valid
+}
+
+// Not returns the negation of b, or null if b is null.
+func (b Bool) Not() Bool {
+	if !b.Valid {
+		return Bool{}
+	}
+	return BoolFrom(!b.Bool)
+}
+
+// And returns b AND c in three-valued logic, as in SQL: false if either is
+// false, null if either is null, and true otherwise.
+func (b Bool) And(c Bool) Bool {
+	switch {
+	case b.IsFalse() || c.IsFalse():
+		return BoolFrom(false)
+	case !b.Valid || !c.Valid:
+		return Bool{}
+	}
+	return BoolFrom(true)
+}
+
+// Or returns b OR c in three-valued logic, as in SQL: true if either is
+// true, null if either is null, and false otherwise.
+func (b Bool) Or(c Bool) Bool {
+	switch {
+	case b.IsTrue() || c.IsTrue():
+		return BoolFrom(true)
+	case !b.Valid || !c.Valid:
+		return Bool{}
+	}
+	return BoolFrom(false)
+}
+
+// Xor returns b XOR c in three-valued logic: null if either is null, and
+// true if they differ otherwise.
+func (b Bool) Xor(c Bool) Bool {
+	if !b.Valid || !c.Valid {
+		return Bool{}
+	}
+	return BoolFrom(b.Bool != c.Bool)
+}
+
+// Implies returns NOT b OR c in three-valued logic: true if b is false or c
+// is true, null if either is null, and false otherwise.
+func (b Bool) Implies(c Bool) Bool {
+	return b.Not().Or(c)
+}
+
+// All returns the AND of bs in three-valued logic: false if any is false,
+// null if any is null, and true otherwise, including for no bs.
+func All(bs ...Bool) Bool {
+	result := BoolFrom(true)
+	for _, b := range bs {
+		if b.IsFalse() {
+			return BoolFrom(false)
+		}
+		result = result.And(b)
+	}
+	return result
+}
+
+// Any returns the OR of bs in three-valued logic: true if any is true, null
+// if any is null, and false otherwise, including for no bs.
+func Any(bs ...Bool) Bool {
+	result := BoolFrom(false)
+	for _, b := range bs {
+		if b.IsTrue() {
+			return BoolFrom(true)
+		}
+		result = result.Or(b)
+	}
+	return result
+}
@@ -165,6 +165,78 @@ func TestBoolScan(t *testing.T) {
 	assertNullBool(t, null, "scanned null")
 }
 
+func TestBoolLogic(t *testing.T) {
+	T, F, U := BoolFrom(true), BoolFrom(false), Bool{}
+	tests := []struct {
+		b, c                  Bool
+		and, or, xor, implies Bool
+	}{
+		{T, T, T, T, F, T},
+		{T, F, F, T, T, F},
+		{T, U, U, T, U, U},
+		{F, T, F, T, T, T},
+		{F, F, F, F, F, T},
+		{F, U, F, U, U, T},
+		{U, T, U, T, U, T},
+		{U, F, F, U, U, U},
+		{U, U, U, U, U, U},
+	}
+	for _, test := range tests {
+		if got := test.b.And(test.c); got != test.and {
+			t.Errorf("%v AND %v = %v, want %v", test.b, test.c, got, test.and)
+		}
+		if got := test.b.Or(test.c); got != test.or {
+			t.Errorf("%v OR %v = %v, want %v", test.b, test.c, got, test.or)
+		}
+		if got := test.b.Xor(test.c); got != test.xor {
+			t.Errorf("%v XOR %v = %v, want %v", test.b, test.c, got, test.xor)
+		}
+		if got := test.b.Implies(test.c); got != test.implies {
+			t.Errorf("%v IMPLIES %v = %v, want %v", test.b, test.c, got, test.implies)
+		}
+	}
+
+	if T.Not() != F || F.Not() != T || U.Not() != U {
+		t.Error("bad Not")
+	}
+	if !T.IsTrue() || F.IsTrue() || U.IsTrue() {
+		t.Error("bad IsTrue")
+	}
+	if T.IsFalse() || !F.IsFalse() || U.IsFalse() {
+		t.Error("bad IsFalse")
+	}
+	if T.IsUnknown() || F.IsUnknown() || !U.IsUnknown() {
+		t.Error("bad IsUnknown")
+	}
+	if NewBool(true, false).Not() != U {
+		t.Error("Not of null should be the zero Bool")
+	}
+}
+
+func TestBoolAllAny(t *testing.T) {
+	T, F, U := BoolFrom(true), BoolFrom(false), Bool{}
+	tests := []struct {
+		bs       []Bool
+		all, any Bool
+	}{
+		{nil, T, F},
+		{[]Bool{T, T}, T, T},
+		{[]Bool{T, U}, U, T},
+		{[]Bool{U, F}, F, U},
+		{[]Bool{F, F}, F, F},
+		{[]Bool{U, U}, U, U},
+		{[]Bool{NewBool(false, false), T}, U, T},
+	}
+	for _, test := range tests {
+		if got := All(test.bs...); got != test.all {
+			t.Errorf("All(%v) = %v, want %v", test.bs, got, test.all)
+		}
+		if got := Any(test.bs...); got != test.any {
+			t.Errorf("Any(%v) = %v, want %v", test.bs, got, test.any)
+		}
+	}
+}
+
 func assertBool(t *testing.T, b Bool, from string) {
 	if b.Bool != true {
 		t.Errorf("bad %s bool: %v ≠ %v\n", from, b.Bool, true)
@@ -102,6 +102,23 @@ func (b Byte) Ptr() *byte {
 	return &b.Byte
 }
 
+// ValueOr returns this Byte's value, or def if this Byte is null.
+func (b Byte) ValueOr(def byte) byte {
+	if !b.Valid {
+		return def
+	}
+	return b.Byte
+}
+
+// ValueOrZero returns this Byte's value, or the zero value of byte if this
+// Byte is null.
+func (b Byte) ValueOrZero() byte {
+	if !b.Valid {
+		return 0
+	}
+	return b.Byte
+}
+
 // IsZero returns true for invalid Bytes, for future omitempty support (Go 1.4?)
 func (b Byte) IsZero() bool {
 	return !b.Valid
@@ -100,6 +100,23 @@ func (b Bytes) Ptr() *[]byte {
 	return &b.Bytes
 }
 
+// ValueOr returns this Bytes's value, or def if this Bytes is null.
+func (b Bytes) ValueOr(def []byte) []byte {
+	if !b.Valid {
+		return def
+	}
+	return b.Bytes
+}
+
+// ValueOrZero returns this Bytes's value, or the zero value of []byte if this
+// Bytes is null.
+func (b Bytes) ValueOrZero() []byte {
+	if !b.Valid {
+		return nil
+	}
+	return b.Bytes
+}
+
 // IsZero returns true for null or zero Bytes's, for future omitempty support (Go 1.4?)
 func (b Bytes) IsZero() bool {
 	return !b.Valid
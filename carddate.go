@@ -3,16 +3,19 @@ package null
 import (
 	"bytes"
 	"database/sql/driver"
+	"encoding/json"
 	"errors"
 	"fmt"
-	"regexp"
-	"strings"
+	"strconv"
 	"time"
 )
 
 const (
 	invalidYearFrom = 2000
 	invalidYearTo   = 2050
+
+	// cardDateLayout is the layout CardDate is marshalled with.
+	cardDateLayout = "01/06"
 )
 
 // vars
@@ -20,36 +23,30 @@ var (
 	ErrUnknownFormat = errors.New("unknown format of card date")
 	ErrInvalidYear   = errors.New("invalid year in card date")
 	ErrInvalidMonth  = errors.New("invalid month in card date")
-)
+	ErrInvalidDay    = errors.New("invalid day in card date")
 
-var (
-	cardDateRFC3339Regex     = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}[Tt][0-9]{2}:[0-9]{2}:[0-9]{2}[Zz+-:0-9]{1,6}$)")
-	cardDateRFC3339NanoRegex = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}[Tt][0-9]{2}:[0-9]{2}:[0-9]{2}.[0-9]{7,9}[Zz+-:0-9]{1,6}$)")
-	cardDateRFC1123Regex     = regexp.MustCompile("^([A-Za-z]{3}, [0-9]{2} [A-Za-z]{3} [0-9]{4} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Za-z]{3,4}$)")
-	cardDateRFC1123ZRegex    = regexp.MustCompile("^([A-Za-z]{3}, [0-9]{2} [A-Za-z]{3} [0-9]{4} [0-9]{2}:[0-9]{2}:[0-9]{2} [-+]{1}[0-9]{4}$)")
-	cardDateRFC822ZRegex     = regexp.MustCompile("^([0-9]{2} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2} [-+]{1}[0-9]{4}$)")
-	cardDateRFC822Regex      = regexp.MustCompile("^([0-9]{2} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2} [A-Za-z]{3,4}$)")
-	cardDateRFC850Regex      = regexp.MustCompile("^([A-Za-z]{6,9}, [0-9]{2}-[A-Za-z]{3}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Z]{3,4}$)")
-	cardDateRubyFormatRegex  = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2} [+-][0-9]{4} [0-9]{4}$)")
-	cardDateUnixFormatRegex  = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9_ ]{1,2} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Za-z]{3,4} [0-9]{4}$)")
-	cardDateANSICFormatRegex = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9_ ]{1,2} [0-9]{2}:[0-9]{2}:[0-9]{2} [0-9]{4}$)")
+	ErrAmbiguousFormat = errors.New("ambiguous format of card date")
 )
 
-// CardDate is a nullable time.Time. It supports SQL and JSON serialization.
+// CardDate is a nullable card expiry month. It supports SQL and JSON
+// serialization. Its identity is Year and Month: every constructor and
+// decoder stores the first instant of the month in UTC in Time, so CardDates
+// of the same month compare equal with ==. Use Equal or Compare for values
+// whose Time was set directly.
 type CardDate struct {
 	Time  time.Time
 	Valid bool
 }
 
-// NewCardDate creates a new Time.
+// NewCardDate creates a new CardDate of the month of t.
 func NewCardDate(t time.Time, valid bool) CardDate {
 	return CardDate{
-		Time:  t,
+		Time:  normalizeCardDate(t),
 		Valid: valid,
 	}
 }
 
-// CardDateFrom creates a new Time that will always be valid.
+// CardDateFrom creates a new CardDate of the month of t that will always be valid.
 func CardDateFrom(t time.Time) CardDate {
 	return NewCardDate(t, true)
 }
@@ -57,28 +54,18 @@ func CardDateFrom(t time.Time) CardDate {
 // CardDateFromString creates a new Time that valid, if format[01/06] is valid.
 // Else return error and invalid struct.
 func CardDateFromString(s string) (CardDate, error) {
-	t, err := ParseExpToTime(s)
-	if err != nil {
-		return CardDate{}, err
-	}
-	return CardDate{
-		Time:  t,
-		Valid: true,
-	}, nil
+	return DefaultCardDateParser.ParseCardDate(s)
 }
 
 // CardDateFromMustString creates a new Time that valid, if format[01/06] is valid.
 // Else return error and invalid struct.
 // NOTE: Use only for TEST purposes!
 func CardDateFromMustString(s string) CardDate {
-	t, err := ParseExpToTime(s)
+	t, err := DefaultCardDateParser.ParseCardDate(s)
 	if err != nil {
 		panic(err)
 	}
-	return CardDate{
-		Time:  t,
-		Valid: true,
-	}
+	return t
 }
 
 // MarshalJSON implements json.Marshaler.
@@ -87,22 +74,44 @@ func (t CardDate) MarshalJSON() ([]byte, error) {
 		return NullBytes, nil // @TODO it should be an error
 	}
 
-	return []byte(`"` + t.Time.Format("01/06") + `"`), nil
+	return []byte(`"` + t.Time.Format(cardDateLayout) + `"`), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler.
 func (t *CardDate) UnmarshalJSON(data []byte) error {
+	return t.unmarshalJSON(data, true)
+}
+
+// unmarshalJSON decodes data, checking it against the policy of
+// DefaultCardDateParser if check is true.
+func (t *CardDate) unmarshalJSON(data []byte, check bool) error {
 	if bytes.Equal(data, NullBytes) {
 		t.Valid = false
 		t.Time = time.Time{}
+		if check {
+			return DefaultCardDateParser.checkNull()
+		}
 		return nil
 	}
 
-	str := strings.TrimPrefix(string(data), `"`)
-	str = strings.TrimSuffix(str, `"`)
-	str = strings.Replace(str, `\`, "", -1)
 	var err error
-	t.Time, err = ParseExpToTime(str)
+	switch {
+	case bytes.HasPrefix(data, []byte("{")):
+		t.Time, err = DefaultCardDateParser.parseObject(data)
+	case bytes.HasPrefix(data, []byte(`"`)):
+		var str string
+		if err = json.Unmarshal(data, &str); err == nil {
+			t.Time, err = DefaultCardDateParser.parse(str, false)
+		}
+	default:
+		t.Time, err = DefaultCardDateParser.parse(string(data), false)
+	}
+	if err == nil {
+		t.Time = normalizeCardDate(t.Time)
+		if check {
+			err = DefaultCardDateParser.check(t.Time)
+		}
+	}
 	if err != nil {
 		t.Valid = false
 		t.Time = time.Time{}
@@ -119,7 +128,7 @@ func (t CardDate) MarshalText() ([]byte, error) {
 		return NullBytes, nil
 	}
 
-	return []byte(t.Time.Format("01/06")), nil
+	return []byte(t.Time.Format(cardDateLayout)), nil
 }
 
 // UnmarshalText implements encoding.TextUnmarshaler.
@@ -127,51 +136,100 @@ func (t *CardDate) UnmarshalText(text []byte) error {
 	if bytes.Equal(text, NullBytes) {
 		t.Valid = false
 		t.Time = time.Time{}
-		return nil
+		return DefaultCardDateParser.checkNull()
 	}
 
 	var err error
-	t.Time, err = ParseExpToTime(string(text))
+	t.Time, err = DefaultCardDateParser.parse(string(text), false)
+	if err == nil {
+		t.Time = normalizeCardDate(t.Time)
+		err = DefaultCardDateParser.check(t.Time)
+	}
 	if err != nil {
 		t.Valid = false
+		t.Time = time.Time{}
 		return err
 	}
 	t.Valid = true
 	return nil
 }
 
-// SetValid changes this Time's value and sets it to be non-null.
+// SetValid changes this CardDate's value to the month of v and sets it to be non-null.
 func (t *CardDate) SetValid(v time.Time) {
-	t.Time = v
+	t.Time = normalizeCardDate(v)
 	t.Valid = true
 }
 
 // SetValidFromStr ...
 func (t *CardDate) SetValidFromStr(v string) {
-	parsed, _ := ParseExpToTime(v)
-	t.Time = parsed
+	parsed, _ := DefaultCardDateParser.parse(v, false)
+	t.Time = normalizeCardDate(parsed)
 	t.Valid = true
 }
 
+// Ptr returns a pointer to this CardDate's value, or a nil pointer if this
+// CardDate is null.
+func (t CardDate) Ptr() *time.Time {
+	if !t.Valid {
+		return nil
+	}
+	return &t.Time
+}
+
+// IsZero returns true for invalid CardDates, for omitempty support.
+func (t CardDate) IsZero() bool {
+	return !t.Valid
+}
+
+// ValueOr returns this CardDate's value, or def if this CardDate is null.
+func (t CardDate) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// ValueOrZero returns this CardDate's value, or the zero time.Time if this
+// CardDate is null.
+func (t CardDate) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
 // Scan implements the Scanner interface.
+// It accepts time.Time, the formats of ParseExpToTime as string or []byte,
+// and YYYYMM as int64.
 func (t *CardDate) Scan(value interface{}) error {
 	var err error
 	switch x := value.(type) {
 	case time.Time:
 		t.Time = x
 	case string:
-		t.Time, err = ParseExpToTime(x)
+		t.Time, err = DefaultCardDateParser.parse(x, false)
+	case []byte:
+		t.Time, err = DefaultCardDateParser.parse(string(x), false)
+	case int64:
+		t.Time, err = DefaultCardDateParser.parseFixed("YYYYMM", strconv.FormatInt(x, 10))
 	case nil:
 		t.Valid = false
-		return nil
+		t.Time = time.Time{}
+		return DefaultCardDateParser.checkNull()
 	default:
-		err = fmt.Errorf("null: cannot scan type %T into null.Time: %v", value, value)
+		err = fmt.Errorf("null: cannot scan type %T into null.CardDate: %v", value, value)
+	}
+	if err == nil {
+		t.Time = normalizeCardDate(t.Time)
+		err = DefaultCardDateParser.check(t.Time)
 	}
 	t.Valid = err == nil
 	return err
 }
 
 // Value implements the driver Valuer interface.
+// It stores a time.Time; use CardDateString or CardDateInt for text or
+// integer columns.
 func (t CardDate) Value() (driver.Value, error) {
 	if !t.Valid {
 		return nil, nil
@@ -179,28 +237,105 @@ func (t CardDate) Value() (driver.Value, error) {
 	return t.Time, nil
 }
 
-// AddDate ...
+// AddDate adds to t like time.Time.AddDate and returns the month of the result.
+// Use AddMonths to move by whole months without the normalization of days.
 func (t CardDate) AddDate(years int, months int, days int) CardDate {
-	n := t.Time.AddDate(years, months, days)
-	t.Time = n
+	t.Time = normalizeCardDate(t.Time.AddDate(years, months, days))
 	return t
 }
 
+// ExpiresAt returns the last instant of the expiry month in loc.
+// A card is valid through the end of its expiry month.
+// It returns the zero time for a null CardDate.
+func (t CardDate) ExpiresAt(loc *time.Location) time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	if loc == nil {
+		loc = time.UTC
+	}
+	first := time.Date(t.Time.Year(), t.Time.Month(), 1, 0, 0, 0, 0, loc)
+	return first.AddDate(0, 1, 0).Add(-time.Nanosecond)
+}
+
+// IsExpired reports whether the card is expired at now.
+// The expiry month is evaluated in the location of now.
+// A null CardDate is always expired.
+func (t CardDate) IsExpired(now time.Time) bool {
+	return t.IsExpiredWithGrace(now, 0)
+}
+
+// IsExpiredWithGrace is like IsExpired, but keeps the card valid for
+// the grace period after the end of the expiry month.
+func (t CardDate) IsExpiredWithGrace(now time.Time, grace time.Duration) bool {
+	if !t.Valid {
+		return true
+	}
+	return now.After(t.ExpiresAt(now.Location()).Add(grace))
+}
+
+// IsExpiredNow reports whether the card is expired according to DefaultClock.
+func (t CardDate) IsExpiredNow() bool {
+	return t.IsExpired(DefaultClock.Now())
+}
+
+// MonthsUntilExpiry returns the number of months from the month of now to the
+// expiry month. It is 0 during the expiry month and negative once expired.
+// It returns 0 for a null CardDate.
+func (t CardDate) MonthsUntilExpiry(now time.Time) int {
+	if !t.Valid {
+		return 0
+	}
+	return monthIndex(t.Time.Year(), t.Time.Month()) - monthIndex(now.Year(), now.Month())
+}
+
+func monthIndex(year int, month time.Month) int {
+	return year*12 + int(month) - 1
+}
+
 // String ...
 func (t CardDate) String() string {
 	if t.Valid {
-		return t.Time.Format("01/06")
+		return t.Time.Format(cardDateLayout)
 	}
 	return "null"
 }
 
-// Validate ...
+// Validate checks that str is a parsable card date. It ignores its receiver.
+//
+// Deprecated: use ParseExpToTime to check a string,
+// or CardDatePolicy.Validate to check a CardDate.
 func (t *CardDate) Validate(str string) error {
 	_, err := stringToTime(str)
 	return err
 }
 
+// Randomize for sqlboiler
+// It generates the first day of a month in the year window of
+// DefaultCardDateParser, which survives a round trip through the storage
+// fieldType names, see cardDateStorageOf.
+func (t *CardDate) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		t.Time = time.Time{}
+		t.Valid = false
+		return
+	}
+
+	from, to := DefaultCardDateParser.YearWindow()
+	if cardDateStorageOf(fieldType) == cardDateStorageString && to-from >= 100 {
+		// MM/YY keeps only the hundred years ending with the last year.
+		from = to - 99
+	}
+	year := from + int(nextInt()%int64(to-from+1))
+	month := time.Month(1 + nextInt()%12)
+	t.Time = time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	t.Valid = true
+}
+
 // GenerateCardDate ...
+//
+// Deprecated: use CardDate.Randomize, this may generate dates rejected
+// by ParseExpToTime.
 func GenerateCardDate(nextInt func() int64, fieldType string, shouldBeNull bool) CardDate {
 	t := CardDate{}
 	if shouldBeNull {
@@ -226,60 +361,13 @@ func GenerateCardDate(nextInt func() int64, fieldType string, shouldBeNull bool)
 // Supported formats: ANSIC, UnixDate, RubyDate, RFC822, RFC822Z, RFC850,
 // 					  RFC1123, RFC1123Z, RFC3339, RFC3339Nano, MM/YY, MMYY
 //					  MM-YY, MM/YYYY, MMYYYY, MM-YYYY
+// The accepted years are defined by DefaultCardDateParser, month names are
+// accepted for the locales in DefaultCardDateParser.Locales.
+// Errors are of type *CardDateError and match the Err* variables with errors.Is.
 func ParseExpToTime(exp string) (time.Time, error) {
 	return stringToTime(exp)
 }
 
 func stringToTime(s string) (time.Time, error) {
-	switch {
-	case cardDateRFC3339Regex.MatchString(s):
-		return timeParser(time.RFC3339, s)
-	case cardDateRFC3339NanoRegex.MatchString(s):
-		return timeParser(time.RFC3339Nano, s)
-	case cardDateRFC1123ZRegex.MatchString(s):
-		return timeParser(time.RFC1123Z, s)
-	case cardDateRFC1123Regex.MatchString(s):
-		return timeParser(time.RFC1123, s)
-	case cardDateRFC850Regex.MatchString(s):
-		return timeParser(time.RFC850, s)
-	case cardDateRFC822Regex.MatchString(s):
-		return timeParser(time.RFC822, s)
-	case cardDateRFC822ZRegex.MatchString(s):
-		return timeParser(time.RFC822Z, s)
-	case cardDateRubyFormatRegex.MatchString(s):
-		return timeParser(time.RubyDate, s)
-	case cardDateUnixFormatRegex.MatchString(s):
-		return timeParser(time.UnixDate, s)
-	case cardDateANSICFormatRegex.MatchString(s):
-		return timeParser(time.ANSIC, s)
-	case len(s) == 4:
-		return timeParser("0106", s)
-	case len(s) == 6:
-		return timeParser("012006", s)
-	case len(s) == 5 && strings.Contains(s, "/"):
-		return timeParser("01/06", s)
-	case len(s) == 5 && strings.Contains(s, "-"):
-		return timeParser("01-06", s)
-	case len(s) == 7 && strings.Contains(s, "/"):
-		return timeParser("01/2006", s)
-	case len(s) == 7 && strings.Contains(s, "-"):
-		return timeParser("01-2006", s)
-	}
-
-	return time.Time{}, ErrUnknownFormat
-}
-
-func timeParser(layout, value string) (time.Time, error) {
-	t, err := time.Parse(layout, value)
-	if err != nil && strings.Contains(err.Error(), "month out of range") {
-		return t, ErrInvalidMonth
-	}
-	if err != nil {
-		return time.Time{}, err
-	}
-	t = t.UTC()
-	if t.Year() <= invalidYearFrom || t.Year() > invalidYearTo {
-		return time.Time{}, ErrInvalidYear
-	}
-	return t, err
+	return DefaultCardDateParser.Parse(s)
 }
@@ -0,0 +1,181 @@
+package null
+
+import (
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CardDateError describes why a card date could not be parsed.
+// It unwraps to ErrUnknownFormat, ErrInvalidMonth, ErrInvalidYear,
+// ErrInvalidDay or a *time.ParseError, so it can be checked with errors.Is
+// and errors.As.
+type CardDateError struct {
+	// Input is the value being parsed. Packed BCD input is given as hex digits.
+	Input string
+	// Format is the detected format of Input, e.g. MM/YY, YYMM or RFC3339.
+	// It is empty if the format is unknown.
+	Format string
+	// Offset is the byte offset of the offending part of Input, or -1.
+	Offset int
+	// Component names the offending part: month, year, day, time, zone or
+	// weekday. It is empty if the whole Input is at fault.
+	Component string
+	// Err is the underlying cause.
+	Err error
+}
+
+// Error implements error.
+func (e *CardDateError) Error() string {
+	var b strings.Builder
+	b.WriteString("null: cannot parse card date ")
+	b.WriteString(strconv.Quote(e.Input))
+	if e.Format != "" {
+		b.WriteString(" as ")
+		b.WriteString(e.Format)
+	}
+	b.WriteString(": ")
+	b.WriteString(e.Err.Error())
+	if e.Component != "" && e.Offset >= 0 {
+		b.WriteString(" (")
+		b.WriteString(e.Component)
+		b.WriteString(" at offset ")
+		b.WriteString(strconv.Itoa(e.Offset))
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+// Unwrap returns the underlying cause.
+func (e *CardDateError) Unwrap() error {
+	return e.Err
+}
+
+const (
+	componentYear = iota
+	componentMonth
+	componentDay
+)
+
+var (
+	componentNames  = [...]string{"year", "month", "day"}
+	componentErrors = [...]error{ErrInvalidYear, ErrInvalidMonth, ErrInvalidDay}
+)
+
+func newComponentError(input, format string, component, offset int) *CardDateError {
+	return &CardDateError{
+		Input:     input,
+		Format:    format,
+		Offset:    offset,
+		Component: componentNames[component],
+		Err:       componentErrors[component],
+	}
+}
+
+// newLayoutError converts an error of time.Parse into a *CardDateError.
+func newLayoutError(layout, value string, err error) *CardDateError {
+	e := &CardDateError{Input: value, Format: layoutName(layout), Offset: -1, Err: err}
+	pe, ok := err.(*time.ParseError)
+	if !ok {
+		return e
+	}
+	switch pe.Message {
+	case "":
+		e.Offset = len(pe.Value) - len(pe.ValueElem)
+		e.Component = layoutComponent(pe.LayoutElem)
+	case ": month out of range":
+		e.Component, e.Err = "month", ErrInvalidMonth
+		if offset := len(pe.Value) - len(pe.ValueElem) - 2; offset >= 0 {
+			e.Offset = offset
+		}
+	case ": day out of range":
+		e.Component, e.Err = "day", ErrInvalidDay
+	}
+	return e
+}
+
+func bcdError(format string, b []byte, err error) error {
+	if e, ok := err.(*CardDateError); ok {
+		e.Input = strings.ToUpper(hex.EncodeToString(b))
+		e.Format = "BCD " + format
+	}
+	return err
+}
+
+var layoutNames = map[string]string{
+	time.RFC3339:     "RFC3339",
+	time.RFC3339Nano: "RFC3339Nano",
+	time.RFC1123:     "RFC1123",
+	time.RFC1123Z:    "RFC1123Z",
+	time.RFC822:      "RFC822",
+	time.RFC822Z:     "RFC822Z",
+	time.RFC850:      "RFC850",
+	time.RubyDate:    "RubyDate",
+	time.UnixDate:    "UnixDate",
+	time.ANSIC:       "ANSIC",
+}
+
+var namedLayouts = func() map[string]string {
+	m := make(map[string]string, len(layoutNames))
+	for layout, name := range layoutNames {
+		m[name] = layout
+	}
+	return m
+}()
+
+func layoutName(layout string) string {
+	if name, ok := layoutNames[layout]; ok {
+		return name
+	}
+	return layout
+}
+
+// layoutComponent names the component a time.Parse layout element stands for.
+func layoutComponent(elem string) string {
+	switch elem {
+	case "2006", "06":
+		return "year"
+	case "01", "1", "Jan", "January":
+		return "month"
+	case "02", "2", "_2":
+		return "day"
+	case "Mon", "Monday":
+		return "weekday"
+	case "15", "03", "3", "04", "4", "05", "5", "PM", "pm":
+		return "time"
+	case "MST", "Z07:00", "Z0700", "-07:00", "-0700", "-07":
+		return "zone"
+	}
+	return ""
+}
+
+// yearOffset finds the year in a value parsed with one of the RFC layouts.
+// A four digit year is the first run of exactly four digits not being a zone
+// offset, a two digit year follows the month name.
+func yearOffset(layout, value string) int {
+	width := 2
+	if strings.Contains(layout, "2006") {
+		width = 4
+	}
+	month := false
+	for i := 0; i < len(value); {
+		j := i
+		for j < len(value) && isDigit(value[j]) {
+			j++
+		}
+		switch {
+		case j == i:
+			if isLetter(value[i]) && i >= 2 && isDigit(value[i-2]) {
+				month = true
+			}
+			j++
+		case width == 4 && j-i == 4 && (i == 0 || !isSign(value[i-1:])):
+			return i
+		case width == 2 && month:
+			return i
+		}
+		i = j
+	}
+	return -1
+}
@@ -0,0 +1,184 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCardDateError(t *testing.T) {
+	tests := []struct {
+		name      string
+		exp       string
+		format    string
+		offset    int
+		component string
+		err       error
+	}{
+		{
+			name:      "Invalid month before invalid year",
+			exp:       "13/2O25",
+			format:    "MM/YYYY",
+			offset:    0,
+			component: "month",
+			err:       ErrInvalidMonth,
+		},
+		{
+			name:      "Letter in year",
+			exp:       "12/2O25",
+			format:    "MM/YYYY",
+			offset:    4,
+			component: "year",
+			err:       ErrInvalidYear,
+		},
+		{
+			name:      "Letter in month",
+			exp:       "1x25",
+			format:    "MMYY",
+			offset:    1,
+			component: "month",
+			err:       ErrInvalidMonth,
+		},
+		{
+			name:      "Year out of window",
+			exp:       "09-2051",
+			format:    "MM-YYYY",
+			offset:    3,
+			component: "year",
+			err:       ErrInvalidYear,
+		},
+		{
+			name:   "Unknown format",
+			exp:    "invalidformat",
+			offset: -1,
+			err:    ErrUnknownFormat,
+		},
+		{
+			name:   "Unknown separator",
+			exp:    "09.25",
+			offset: -1,
+			err:    ErrUnknownFormat,
+		},
+		{
+			name:      "RFC3339 month out of range",
+			exp:       "2023-13-30T00:00:00Z",
+			format:    "RFC3339",
+			offset:    5,
+			component: "month",
+			err:       ErrInvalidMonth,
+		},
+		{
+			name:      "RFC1123 unknown month name",
+			exp:       "Mon, 02 Xov 2023 15:04:05 MST",
+			format:    "RFC1123",
+			offset:    8,
+			component: "month",
+		},
+		{
+			name:      "RFC822 year out of window",
+			exp:       "02 Nov 55 15:04 MST",
+			format:    "RFC822",
+			offset:    7,
+			component: "year",
+			err:       ErrInvalidYear,
+		},
+		{
+			name:      "RubyDate year out of window",
+			exp:       "Mon Nov 02 15:04:05 -0700 2063",
+			format:    "RubyDate",
+			offset:    26,
+			component: "year",
+			err:       ErrInvalidYear,
+		},
+		{
+			name:      "RFC850 year out of window",
+			exp:       "Monday, 02-Nov-55 15:04:05 MST",
+			format:    "RFC850",
+			offset:    15,
+			component: "year",
+			err:       ErrInvalidYear,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseExpToTime(test.exp)
+			var e *CardDateError
+			if !errors.As(err, &e) {
+				t.Fatalf("error should be *CardDateError, instead of %T", err)
+			}
+			if e.Input != test.exp {
+				t.Errorf("Input got = %q, want %q", e.Input, test.exp)
+			}
+			if e.Format != test.format {
+				t.Errorf("Format got = %q, want %q", e.Format, test.format)
+			}
+			if e.Offset != test.offset {
+				t.Errorf("Offset got = %d, want %d", e.Offset, test.offset)
+			}
+			if e.Component != test.component {
+				t.Errorf("Component got = %q, want %q", e.Component, test.component)
+			}
+			if test.err != nil && !errors.Is(err, test.err) {
+				t.Errorf("error should be %v, instead of %v", test.err, e.Err)
+			}
+		})
+	}
+}
+
+func TestCardDateError_ParseError(t *testing.T) {
+	_, err := ParseExpToTime("Mon, 02 Xov 2023 15:04:05 MST")
+	var pe *time.ParseError
+	if !errors.As(err, &pe) {
+		t.Fatalf("error should wrap *time.ParseError, instead of %v", err)
+	}
+}
+
+func TestCardDateError_BCD(t *testing.T) {
+	_, err := ParseBCDYYMMDD([]byte{0x25, 0x09, 0x31})
+	var e *CardDateError
+	if !errors.As(err, &e) {
+		t.Fatalf("error should be *CardDateError, instead of %T", err)
+	}
+	if e.Input != "250931" || e.Format != "BCD YYMMDD" || e.Offset != 4 || e.Component != "day" {
+		t.Errorf("unexpected error %#v", e)
+	}
+	if !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("error should be %v, instead of %v", ErrInvalidDay, e.Err)
+	}
+}
+
+func TestCardDateError_Error(t *testing.T) {
+	_, err := ParseExpToTime("13/2O25")
+	want := `null: cannot parse card date "13/2O25" as MM/YYYY: invalid month in card date (month at offset 0)`
+	if err.Error() != want {
+		t.Errorf("Error() got = %s, want %s", err.Error(), want)
+	}
+
+	_, err = ParseExpToTime("invalidformat")
+	want = `null: cannot parse card date "invalidformat": unknown format of card date`
+	if err.Error() != want {
+		t.Errorf("Error() got = %s, want %s", err.Error(), want)
+	}
+}
+
+func TestCardDateError_EntryPoints(t *testing.T) {
+	var e *CardDateError
+
+	var s testStruct
+	err := json.Unmarshal([]byte(`{"expiration_date":"13/25"}`), &s)
+	if !errors.As(err, &e) {
+		t.Errorf("UnmarshalJSON error should be *CardDateError, instead of %T", err)
+	}
+
+	var d CardDate
+	if err := d.UnmarshalText([]byte("13/25")); !errors.As(err, &e) {
+		t.Errorf("UnmarshalText error should be *CardDateError, instead of %T", err)
+	}
+	if err := d.Scan("13/25"); !errors.As(err, &e) {
+		t.Errorf("Scan error should be *CardDateError, instead of %T", err)
+	}
+	if _, err := CardDateFromString("13/25"); !errors.As(err, &e) {
+		t.Errorf("CardDateFromString error should be *CardDateError, instead of %T", err)
+	}
+}
@@ -0,0 +1,182 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"time"
+)
+
+// Output layouts of the CardDate variants of the same name,
+// as accepted by time.Format and CardDate.Format.
+const (
+	CardDateLayoutMMYYYY = "01/2006"
+	CardDateLayoutMMYY   = "0106"
+	CardDateLayoutYYMM   = "0601"
+)
+
+// Format returns the CardDate formatted with a time.Format layout,
+// or an empty string if it is null.
+func (t CardDate) Format(layout string) string {
+	if !t.Valid {
+		return ""
+	}
+	return t.Time.Format(layout)
+}
+
+func (t CardDate) marshalJSONLayout(layout string) ([]byte, error) {
+	if !t.Valid {
+		return NullBytes, nil
+	}
+	return []byte(`"` + t.Time.Format(layout) + `"`), nil
+}
+
+func (t CardDate) marshalTextLayout(layout string) ([]byte, error) {
+	if !t.Valid {
+		return NullBytes, nil
+	}
+	return []byte(t.Time.Format(layout)), nil
+}
+
+func (t CardDate) stringLayout(layout string) string {
+	if !t.Valid {
+		return "null"
+	}
+	return t.Time.Format(layout)
+}
+
+// CardDateMMYYYY is a CardDate that marshals as MM/YYYY.
+// It parses every format CardDate does.
+type CardDateMMYYYY struct {
+	CardDate
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t CardDateMMYYYY) MarshalJSON() ([]byte, error) {
+	return t.marshalJSONLayout(CardDateLayoutMMYYYY)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CardDateMMYYYY) MarshalText() ([]byte, error) {
+	return t.marshalTextLayout(CardDateLayoutMMYYYY)
+}
+
+// String returns the CardDate as MM/YYYY.
+func (t CardDateMMYYYY) String() string {
+	return t.stringLayout(CardDateLayoutMMYYYY)
+}
+
+// CardDateMMYY is a CardDate that marshals as MMYY, without a separator.
+// It parses every format CardDate does.
+type CardDateMMYY struct {
+	CardDate
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t CardDateMMYY) MarshalJSON() ([]byte, error) {
+	return t.marshalJSONLayout(CardDateLayoutMMYY)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CardDateMMYY) MarshalText() ([]byte, error) {
+	return t.marshalTextLayout(CardDateLayoutMMYY)
+}
+
+// String returns the CardDate as MMYY.
+func (t CardDateMMYY) String() string {
+	return t.stringLayout(CardDateLayoutMMYY)
+}
+
+// CardDateYYMM is a CardDate that marshals as YYMM, as used by ISO 8583.
+// Four digit input is read as YYMM, anything else like CardDate.
+type CardDateYYMM struct {
+	CardDate
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t CardDateYYMM) MarshalJSON() ([]byte, error) {
+	return t.marshalJSONLayout(CardDateLayoutYYMM)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *CardDateYYMM) UnmarshalJSON(data []byte) error {
+	if len(data) == 6 && data[0] == '"' && data[5] == '"' {
+		return t.UnmarshalText(data[1:5])
+	}
+	return t.CardDate.UnmarshalJSON(data)
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (t CardDateYYMM) MarshalText() ([]byte, error) {
+	return t.marshalTextLayout(CardDateLayoutYYMM)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *CardDateYYMM) UnmarshalText(text []byte) error {
+	if len(text) != 4 || bytes.Equal(text, NullBytes) {
+		return t.CardDate.UnmarshalText(text)
+	}
+	var err error
+	t.CardDate, err = CardDateFromYYMM(string(text))
+	return err
+}
+
+// Scan implements the Scanner interface.
+func (t *CardDateYYMM) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case string:
+		return t.UnmarshalText([]byte(v))
+	case []byte:
+		return t.UnmarshalText(v)
+	}
+	return t.CardDate.Scan(value)
+}
+
+// String returns the CardDate as YYMM.
+func (t CardDateYYMM) String() string {
+	return t.stringLayout(CardDateLayoutYYMM)
+}
+
+// CardDateObject is a CardDate that marshals to JSON as {"month":9,"year":2025}.
+// It parses every format CardDate does.
+type CardDateObject struct {
+	CardDate
+}
+
+// MarshalJSON implements json.Marshaler.
+func (t CardDateObject) MarshalJSON() ([]byte, error) {
+	if !t.Valid {
+		return NullBytes, nil
+	}
+	b := make([]byte, 0, 26)
+	b = append(b, `{"month":`...)
+	b = strconv.AppendInt(b, int64(t.Time.Month()), 10)
+	b = append(b, `,"year":`...)
+	b = strconv.AppendInt(b, int64(t.Time.Year()), 10)
+	return append(b, '}'), nil
+}
+
+const cardDateObjectFormat = "JSON object"
+
+type cardDateObject struct {
+	Month int `json:"month"`
+	Year  int `json:"year"`
+}
+
+// parseObject parses the {"month":9,"year":2025} form of a card date.
+// A year below 100 is resolved like a two digit year.
+func (p *CardDateParser) parseObject(data []byte) (time.Time, error) {
+	var o cardDateObject
+	if err := json.Unmarshal(data, &o); err != nil {
+		return time.Time{}, &CardDateError{Input: string(data), Format: cardDateObjectFormat, Offset: -1, Err: err}
+	}
+	t, err := p.date(o.Year, o.Year < 100, o.Month, 1)
+	switch err {
+	case nil:
+		return t, nil
+	case ErrInvalidMonth:
+		return t, newComponentError(string(data), cardDateObjectFormat, componentMonth, -1)
+	default:
+		return t, newComponentError(string(data), cardDateObjectFormat, componentYear, -1)
+	}
+}
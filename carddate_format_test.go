@@ -0,0 +1,175 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type formatStruct struct {
+	Default CardDate       `json:"default"`
+	Long    CardDateMMYYYY `json:"long"`
+	Short   CardDateMMYY   `json:"short"`
+	ISO     CardDateYYMM   `json:"iso"`
+	Object  CardDateObject `json:"object"`
+}
+
+func TestCardDateFormat_MarshalJSON(t *testing.T) {
+	date := CardDateFrom(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name  string
+		value formatStruct
+		want  string
+	}{
+		{
+			name: "Valid",
+			value: formatStruct{
+				Default: date,
+				Long:    CardDateMMYYYY{date},
+				Short:   CardDateMMYY{date},
+				ISO:     CardDateYYMM{date},
+				Object:  CardDateObject{date},
+			},
+			want: `{"default":"09/25","long":"09/2025","short":"0925","iso":"2509","object":{"month":9,"year":2025}}`,
+		},
+		{
+			name:  "Null",
+			value: formatStruct{},
+			want:  `{"default":null,"long":null,"short":null,"iso":null,"object":null}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			data, err := json.Marshal(test.value)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(data) != test.want {
+				t.Errorf("Json should be %s, instead of %s", test.want, string(data))
+			}
+
+			var back formatStruct
+			if err := json.Unmarshal(data, &back); err != nil {
+				t.Fatal(err)
+			}
+			if back != test.value {
+				t.Errorf("Round trip should give %v, instead of %v", test.value, back)
+			}
+		})
+	}
+}
+
+func TestCardDateFormat_MarshalText(t *testing.T) {
+	date := CardDateFrom(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name  string
+		value interface{ MarshalText() ([]byte, error) }
+		want  string
+	}{
+		{name: "MM/YYYY", value: CardDateMMYYYY{date}, want: "09/2025"},
+		{name: "MMYY", value: CardDateMMYY{date}, want: "0925"},
+		{name: "YYMM", value: CardDateYYMM{date}, want: "2509"},
+		{name: "Object", value: CardDateObject{date}, want: "09/25"},
+		{name: "Null", value: CardDateYYMM{}, want: "null"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			text, err := test.value.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if string(text) != test.want {
+				t.Errorf("Text should be %s, instead of %s", test.want, string(text))
+			}
+		})
+	}
+}
+
+func TestCardDateFormat_String(t *testing.T) {
+	date := CardDateFrom(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+	if got := (CardDateMMYYYY{date}).String(); got != "09/2025" {
+		t.Errorf("String() got = %s, want 09/2025", got)
+	}
+	if got := (CardDateMMYY{date}).String(); got != "0925" {
+		t.Errorf("String() got = %s, want 0925", got)
+	}
+	if got := (CardDateYYMM{date}).String(); got != "2509" {
+		t.Errorf("String() got = %s, want 2509", got)
+	}
+	if got := (CardDateYYMM{}).String(); got != "null" {
+		t.Errorf("String() got = %s, want null", got)
+	}
+	if got := date.Format("2006-01"); got != "2025-09" {
+		t.Errorf("Format() got = %s, want 2025-09", got)
+	}
+	if got := (CardDate{}).Format("2006-01"); got != "" {
+		t.Errorf("Format() got = %s, want empty string", got)
+	}
+}
+
+func TestCardDateFormat_UnmarshalLiberal(t *testing.T) {
+	var v formatStruct
+	data := `{"default":{"month":9,"year":2025},"long":"09/25","short":"2025-09-30T00:00:00Z","iso":"09/2025","object":"0925"}`
+	if err := json.Unmarshal([]byte(data), &v); err != nil {
+		t.Fatal(err)
+	}
+	for _, got := range []CardDate{v.Default, v.Long.CardDate, v.Short.CardDate, v.ISO.CardDate, v.Object.CardDate} {
+		if !got.Valid || got.Time.Year() != 2025 || got.Time.Month() != time.September {
+			t.Errorf("CardDate should be 09/25, instead of %v", got)
+		}
+	}
+}
+
+func TestCardDateYYMM_Scan(t *testing.T) {
+	var d CardDateYYMM
+	if err := d.Scan("2509"); err != nil {
+		t.Fatal(err)
+	}
+	if d.String() != "2509" {
+		t.Errorf("Scan should give 2509, instead of %s", d.String())
+	}
+	d = CardDateYYMM{}
+	if err := d.Scan([]byte("2509")); err != nil {
+		t.Fatal(err)
+	}
+	if d.String() != "2509" {
+		t.Errorf("Scan([]byte) should give 2509, instead of %s", d.String())
+	}
+	if err := d.UnmarshalText([]byte("null")); err != nil || d.Valid {
+		t.Errorf("UnmarshalText(null) should give null, instead of %v, %v", d, err)
+	}
+	if err := d.Scan(nil); err != nil || d.Valid {
+		t.Errorf("Scan(nil) should give null, instead of %v, %v", d, err)
+	}
+}
+
+func TestCardDate_UnmarshalJSONObject(t *testing.T) {
+	tests := []struct {
+		name string
+		json string
+		err  error
+	}{
+		{name: "Two digit year", json: `{"month":9,"year":25}`},
+		{name: "Invalid month", json: `{"month":13,"year":2025}`, err: ErrInvalidMonth},
+		{name: "Invalid year", json: `{"month":9,"year":2075}`, err: ErrInvalidYear},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var d CardDate
+			err := d.UnmarshalJSON([]byte(test.json))
+			if !errors.Is(err, test.err) {
+				t.Fatalf("UnmarshalJSON() error = %v, want %v", err, test.err)
+			}
+			if test.err == nil && d.String() != "09/25" {
+				t.Errorf("UnmarshalJSON() got = %s, want 09/25", d.String())
+			}
+		})
+	}
+
+	var d CardDate
+	var e *CardDateError
+	if err := d.UnmarshalJSON([]byte(`{"month":"x"}`)); !errors.As(err, &e) || e.Format != "JSON object" {
+		t.Errorf("UnmarshalJSON() error should be *CardDateError, instead of %v", err)
+	}
+}
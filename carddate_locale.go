@@ -0,0 +1,246 @@
+package null
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CardDateLocale describes how a locale writes an expiry date with a month
+// name, e.g. "janv. 2027", "März 27" or "27年3月". Add locales to
+// CardDateParser.Locales to accept them in Parse and ParseExpToTime.
+type CardDateLocale struct {
+	// Tag is the BCP 47 tag of the locale, e.g. fr or de-AT.
+	Tag string
+	// Months lists the accepted names of each month, January first. Names are
+	// matched case insensitively and their trailing dot is optional.
+	// The first name of a month is used by CardDate.FormatLocale.
+	Months [12][]string
+	// YearFirst is set if the year is written before the month.
+	YearFirst bool
+	// YearSuffix follows the year, e.g. 年. It is optional when parsing.
+	YearSuffix string
+	// Separator is written between the month and the year by FormatLocale.
+	// When parsing, any run of spaces, dots, commas, slashes and dashes
+	// separates them.
+	Separator string
+}
+
+// Built-in locales.
+var (
+	CardDateLocaleEN = &CardDateLocale{
+		Tag: "en",
+		Months: [12][]string{
+			{"Jan", "January"}, {"Feb", "February"}, {"Mar", "March"}, {"Apr", "April"},
+			{"May"}, {"Jun", "June"}, {"Jul", "July"}, {"Aug", "August"},
+			{"Sep", "Sept", "September"}, {"Oct", "October"}, {"Nov", "November"}, {"Dec", "December"},
+		},
+		Separator: " ",
+	}
+	CardDateLocaleFR = &CardDateLocale{
+		Tag: "fr",
+		Months: [12][]string{
+			{"janv.", "janvier", "jan"}, {"févr.", "février", "fév", "fevr", "fevrier"},
+			{"mars"}, {"avr.", "avril"}, {"mai"}, {"juin"},
+			{"juil.", "juillet"}, {"août", "aout"}, {"sept.", "septembre"},
+			{"oct.", "octobre"}, {"nov.", "novembre"}, {"déc.", "décembre", "dec", "decembre"},
+		},
+		Separator: " ",
+	}
+	CardDateLocaleDE = &CardDateLocale{
+		Tag: "de",
+		Months: [12][]string{
+			{"Jan.", "Januar", "Jän", "Jänner"}, {"Feb.", "Februar"}, {"März", "Mär", "Mrz", "Maerz"},
+			{"Apr.", "April"}, {"Mai"}, {"Juni", "Jun"}, {"Juli", "Jul"}, {"Aug.", "August"},
+			{"Sept.", "September", "Sep"}, {"Okt.", "Oktober"}, {"Nov.", "November"}, {"Dez.", "Dezember"},
+		},
+		Separator: " ",
+	}
+	CardDateLocaleES = &CardDateLocale{
+		Tag: "es",
+		Months: [12][]string{
+			{"ene.", "enero"}, {"feb.", "febrero"}, {"mar.", "marzo"}, {"abr.", "abril"},
+			{"may.", "mayo"}, {"jun.", "junio"}, {"jul.", "julio"}, {"ago.", "agosto"},
+			{"sept.", "septiembre", "sep", "set", "setiembre"}, {"oct.", "octubre"},
+			{"nov.", "noviembre"}, {"dic.", "diciembre"},
+		},
+		Separator: " ",
+	}
+	CardDateLocaleIT = &CardDateLocale{
+		Tag: "it",
+		Months: [12][]string{
+			{"gen", "gennaio"}, {"feb", "febbraio"}, {"mar", "marzo"}, {"apr", "aprile"},
+			{"mag", "maggio"}, {"giu", "giugno"}, {"lug", "luglio"}, {"ago", "agosto"},
+			{"set", "settembre"}, {"ott", "ottobre"}, {"nov", "novembre"}, {"dic", "dicembre"},
+		},
+		Separator: " ",
+	}
+	CardDateLocaleJA = &CardDateLocale{
+		Tag:        "ja",
+		Months:     numberedMonths("月"),
+		YearFirst:  true,
+		YearSuffix: "年",
+	}
+	CardDateLocaleZH = &CardDateLocale{
+		Tag: "zh",
+		Months: withMonthNames(numberedMonths("月"), [12]string{
+			"一月", "二月", "三月", "四月", "五月", "六月",
+			"七月", "八月", "九月", "十月", "十一月", "十二月",
+		}),
+		YearFirst:  true,
+		YearSuffix: "年",
+	}
+	CardDateLocaleKO = &CardDateLocale{
+		Tag:        "ko",
+		Months:     numberedMonths("월"),
+		YearFirst:  true,
+		YearSuffix: "년",
+		Separator:  " ",
+	}
+)
+
+var cardDateLocales = []*CardDateLocale{
+	CardDateLocaleEN, CardDateLocaleFR, CardDateLocaleDE, CardDateLocaleES,
+	CardDateLocaleIT, CardDateLocaleJA, CardDateLocaleZH, CardDateLocaleKO,
+}
+
+// LookupCardDateLocale returns the built-in locale of tag. A regional tag
+// such as fr-CA falls back to its language. It returns nil if there is none.
+func LookupCardDateLocale(tag string) *CardDateLocale {
+	tag = strings.ReplaceAll(tag, "_", "-")
+	if i := strings.IndexByte(tag, '-'); i >= 0 {
+		tag = tag[:i]
+	}
+	for _, l := range cardDateLocales {
+		if strings.EqualFold(l.Tag, tag) {
+			return l
+		}
+	}
+	return nil
+}
+
+// FormatLocale returns the card date written with the month name of l,
+// e.g. "janv. 2027" or "2027年1月", or "null" for a null CardDate.
+func (t CardDate) FormatLocale(l *CardDateLocale) string {
+	if !t.Valid {
+		return "null"
+	}
+	month := l.Months[t.Time.Month()-1][0]
+	year := strconv.Itoa(t.Time.Year()) + l.YearSuffix
+	if l.YearFirst {
+		return year + l.Separator + month
+	}
+	return month + l.Separator + year
+}
+
+// parseLocales parses s with the first of p.Locales it is written in.
+// It reports false if s is written in none of them.
+func (p *CardDateParser) parseLocales(s string) (time.Time, bool, error) {
+	for _, l := range p.Locales {
+		t, err := p.parseLocale(l, s)
+		if err != ErrUnknownFormat {
+			return t, true, err
+		}
+	}
+	return time.Time{}, false, nil
+}
+
+// parseLocale parses s written as the month name and the year in the order
+// of l. It returns the bare ErrUnknownFormat if s is not written so.
+func (p *CardDateParser) parseLocale(l *CardDateLocale, s string) (time.Time, error) {
+	var month, at, digits, i int
+	if l.YearFirst {
+		digits = countDigits(s)
+		i = skipPrefix(s, digits, l.YearSuffix)
+		i = skipSeparators(s, i)
+		if month, i = l.month(s, i); month == 0 {
+			return time.Time{}, ErrUnknownFormat
+		}
+	} else {
+		if month, i = l.month(s, i); month == 0 {
+			return time.Time{}, ErrUnknownFormat
+		}
+		i = skipSeparators(s, i)
+		at, digits = i, countDigits(s[i:])
+		i = skipPrefix(s, i+digits, l.YearSuffix)
+	}
+	if digits == 0 || i != len(s) {
+		return time.Time{}, ErrUnknownFormat
+	}
+
+	format := "month name (" + l.Tag + ")"
+	if digits != 2 && digits != 4 {
+		return time.Time{}, newComponentError(s, format, componentYear, at)
+	}
+	year, _ := strconv.Atoi(s[at : at+digits])
+	t, err := p.date(year, digits == 2, month, 1)
+	if err != nil {
+		return time.Time{}, newComponentError(s, format, componentYear, at)
+	}
+	return t, nil
+}
+
+// month matches the longest month name of l at s[i:] and returns the month
+// and the offset after the name, or 0 if no name matches.
+func (l *CardDateLocale) month(s string, i int) (month, end int) {
+	longest := 0
+	for m, names := range l.Months {
+		for _, name := range names {
+			name = strings.TrimSuffix(name, ".")
+			n := len(name)
+			if n > longest && len(s)-i >= n && strings.EqualFold(s[i:i+n], name) {
+				month, longest = m+1, n
+			}
+		}
+	}
+	if month == 0 {
+		return 0, i
+	}
+	end = skipPrefix(s, i+longest, ".")
+	if end < len(s) && isLetter(s[end]) {
+		return 0, i
+	}
+	return month, end
+}
+
+func countDigits(s string) int {
+	n := 0
+	for n < len(s) && isDigit(s[n]) {
+		n++
+	}
+	return n
+}
+
+func skipPrefix(s string, i int, prefix string) int {
+	if prefix != "" && strings.HasPrefix(s[i:], prefix) {
+		return i + len(prefix)
+	}
+	return i
+}
+
+func skipSeparators(s string, i int) int {
+	for i < len(s) && strings.IndexByte(" .,/-", s[i]) >= 0 {
+		i++
+	}
+	return i
+}
+
+// numberedMonths returns the months written as their number and suffix,
+// with and without a leading zero.
+func numberedMonths(suffix string) [12][]string {
+	var months [12][]string
+	for m := 1; m <= 12; m++ {
+		months[m-1] = []string{strconv.Itoa(m) + suffix}
+		if m < 10 {
+			months[m-1] = append(months[m-1], "0"+strconv.Itoa(m)+suffix)
+		}
+	}
+	return months
+}
+
+func withMonthNames(months [12][]string, names [12]string) [12][]string {
+	for m := range months {
+		months[m] = append(months[m], names[m])
+	}
+	return months
+}
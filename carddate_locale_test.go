@@ -0,0 +1,125 @@
+package null
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCardDateParserLocales(t *testing.T) {
+	p := NewCardDateParser(2001, 2050)
+	p.Locales = []*CardDateLocale{CardDateLocaleFR, CardDateLocaleDE, CardDateLocaleEN, CardDateLocaleJA, CardDateLocaleKO}
+
+	tests := []struct {
+		input string
+		want  time.Time
+	}{
+		{"janv. 2027", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"janvier 2027", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"JANV 27", time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC)},
+		{"März 27", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"MÄRZ 2027", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"Sept./27", time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC)},
+		{"December, 2030", time.Date(2030, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"27年3月", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"2027年12月", time.Date(2027, 12, 1, 0, 0, 0, 0, time.UTC)},
+		{"2027年03月", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{"2027년 3월", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+		// The built-in formats still come first.
+		{"03/27", time.Date(2027, 3, 1, 0, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			got, err := p.Parse(tt.input)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("Parse() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	errTests := []struct {
+		input string
+		want  error
+	}{
+		{"Marc 27", ErrUnknownFormat},
+		{"mars", ErrUnknownFormat},
+		{"mars 27 x", ErrUnknownFormat},
+		{"mars 1999", ErrInvalidYear},
+		{"mars 202", ErrInvalidYear},
+		{"1999年3月", ErrInvalidYear},
+		{"2027年13月", ErrUnknownFormat},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.input, func(t *testing.T) {
+			_, err := p.Parse(tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Parse() error = %v, want %v", err, tt.want)
+			}
+			var cdErr *CardDateError
+			if !errors.As(err, &cdErr) {
+				t.Errorf("Parse() error type = %T, want *CardDateError", err)
+			}
+		})
+	}
+
+	if _, err := NewCardDateParser(2001, 2050).Parse("janv. 2027"); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Parse() without locales error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestCardDateFormatLocale(t *testing.T) {
+	cd := CardDateFrom(time.Date(2027, 1, 1, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		locale *CardDateLocale
+		want   string
+	}{
+		{CardDateLocaleEN, "Jan 2027"},
+		{CardDateLocaleFR, "janv. 2027"},
+		{CardDateLocaleDE, "Jan. 2027"},
+		{CardDateLocaleJA, "2027年1月"},
+		{CardDateLocaleZH, "2027年1月"},
+		{CardDateLocaleKO, "2027년 1월"},
+	}
+	for _, tt := range tests {
+		if got := cd.FormatLocale(tt.locale); got != tt.want {
+			t.Errorf("FormatLocale(%s) got = %q, want %q", tt.locale.Tag, got, tt.want)
+		}
+	}
+	if got := (CardDate{}).FormatLocale(CardDateLocaleEN); got != "null" {
+		t.Errorf("FormatLocale() of null got = %q, want %q", got, "null")
+	}
+
+	// Formatted dates parse back.
+	p := NewCardDateParser(2001, 2050)
+	for _, l := range cardDateLocales {
+		p.Locales = []*CardDateLocale{l}
+		for m := time.January; m <= time.December; m++ {
+			cd := CardDateFrom(time.Date(2027, m, 1, 0, 0, 0, 0, time.UTC))
+			got, err := p.Parse(cd.FormatLocale(l))
+			if err != nil || !got.Equal(cd.Time) {
+				t.Errorf("Parse(%q) got = %v, %v, want %v", cd.FormatLocale(l), got, err, cd.Time)
+			}
+		}
+	}
+}
+
+func TestLookupCardDateLocale(t *testing.T) {
+	tests := []struct {
+		tag  string
+		want *CardDateLocale
+	}{
+		{"fr", CardDateLocaleFR},
+		{"fr-CA", CardDateLocaleFR},
+		{"de_AT", CardDateLocaleDE},
+		{"JA", CardDateLocaleJA},
+		{"xx", nil},
+	}
+	for _, tt := range tests {
+		if got := LookupCardDateLocale(tt.tag); got != tt.want {
+			t.Errorf("LookupCardDateLocale(%q) got = %v, want %v", tt.tag, got, tt.want)
+		}
+	}
+}
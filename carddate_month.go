@@ -0,0 +1,114 @@
+package null
+
+import "time"
+
+// normalizeCardDate returns the first instant of the month of t in UTC.
+// The month is taken in the location of t, so a date scanned from a
+// database in local time, or parsed from a string with a zone offset, keeps
+// its calendar month.
+func normalizeCardDate(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+}
+
+// Year returns the expiry year, or 0 for a null CardDate.
+func (t CardDate) Year() int {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Year()
+}
+
+// Month returns the expiry month, or 0 for a null CardDate.
+func (t CardDate) Month() time.Month {
+	if !t.Valid {
+		return 0
+	}
+	return t.Time.Month()
+}
+
+// Equal reports whether t and u have the same year and month.
+// Two null CardDates are equal.
+func (t CardDate) Equal(u CardDate) bool {
+	return t.Compare(u) == 0
+}
+
+// Compare returns -1 if t is before u, 0 if they have the same year and
+// month and +1 if t is after u. A null CardDate is before any valid one.
+func (t CardDate) Compare(u CardDate) int {
+	switch {
+	case !t.Valid && !u.Valid:
+		return 0
+	case !t.Valid:
+		return -1
+	case !u.Valid:
+		return 1
+	}
+	a, b := monthIndex(t.Time.Year(), t.Time.Month()), monthIndex(u.Time.Year(), u.Time.Month())
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// Before reports whether the month of t is before the month of u.
+func (t CardDate) Before(u CardDate) bool {
+	return t.Compare(u) < 0
+}
+
+// After reports whether the month of t is after the month of u.
+func (t CardDate) After(u CardDate) bool {
+	return t.Compare(u) > 0
+}
+
+// AddMonths returns the CardDate n months after t, or before it if n is
+// negative. A null CardDate stays null.
+func (t CardDate) AddMonths(n int) CardDate {
+	if !t.Valid {
+		return t
+	}
+	return CardDateFrom(time.Date(t.Time.Year(), t.Time.Month()+time.Month(n), 1, 0, 0, 0, 0, time.UTC))
+}
+
+// CardDateRange returns the months from from to to, both inclusive.
+// It returns nil if either is null or from is after to.
+func CardDateRange(from, to CardDate) []CardDate {
+	if !from.Valid || !to.Valid || from.After(to) {
+		return nil
+	}
+	n := to.MonthsUntilExpiry(from.Time) + 1
+	months := make([]CardDate, n)
+	for i := range months {
+		months[i] = from.AddMonths(i)
+	}
+	return months
+}
+
+// FirstDay returns midnight of the first day of the expiry month in UTC,
+// or the zero time for a null CardDate.
+func (t CardDate) FirstDay() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return normalizeCardDate(t.Time)
+}
+
+// LastDay returns midnight of the last day of the expiry month in UTC,
+// or the zero time for a null CardDate. Use ExpiresAt for the last instant.
+func (t CardDate) LastDay() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	year, month := t.Time.Year(), t.Time.Month()
+	return time.Date(year, month, daysIn(year, month), 0, 0, 0, 0, time.UTC)
+}
+
+// NullTime returns FirstDay as a Time, which is null if t is null.
+func (t CardDate) NullTime() Time {
+	return NewTime(t.FirstDay(), t.Valid)
+}
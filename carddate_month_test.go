@@ -0,0 +1,212 @@
+package null
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCardDateNormalization(t *testing.T) {
+	want := CardDateFrom(time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC))
+	cet := time.FixedZone("CET", 2*60*60)
+
+	var scanned CardDate
+	if err := scanned.Scan(time.Date(2025, 9, 1, 0, 30, 0, 0, cet)); err != nil {
+		t.Fatal(err)
+	}
+	var set CardDate
+	set.SetValid(time.Date(2025, 9, 17, 13, 0, 0, 0, time.UTC))
+
+	var rfc CardDate
+	if err := rfc.UnmarshalText([]byte("2025-09-30T23:00:00-05:00")); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, got := range map[string]CardDate{
+		"CardDateFrom": CardDateFrom(time.Date(2025, 9, 30, 10, 0, 0, 0, cet)),
+		"Scan":         scanned,
+		"SetValid":     set,
+		"RFC3339":      rfc,
+		"AddDate":      CardDateFromMustString("08/25").AddDate(0, 0, 45),
+	} {
+		if got != want {
+			t.Errorf("%s got = %v, want %v", name, got.Time, want.Time)
+		}
+	}
+}
+
+func TestCardDateNormalizationOffset(t *testing.T) {
+	want := CardDateFrom(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))
+	const s = "2023-12-01T00:00:00+07:00"
+	instant := time.Date(2023, 12, 1, 0, 0, 0, 0, time.FixedZone("", 7*60*60))
+
+	var fromTime, fromString, fromBytes, fromJSON, fromText CardDate
+	maybePanic(fromTime.Scan(instant))
+	maybePanic(fromString.Scan(s))
+	maybePanic(fromBytes.Scan([]byte(s)))
+	maybePanic(fromJSON.UnmarshalJSON([]byte(`"` + s + `"`)))
+	maybePanic(fromText.UnmarshalText([]byte(s)))
+	parsed, err := DefaultCardDateParser.ParseCardDate(s)
+	maybePanic(err)
+
+	for name, got := range map[string]CardDate{
+		"Scan(time.Time)": fromTime,
+		"Scan(string)":    fromString,
+		"Scan([]byte)":    fromBytes,
+		"UnmarshalJSON":   fromJSON,
+		"UnmarshalText":   fromText,
+		"ParseCardDate":   parsed,
+		"CardDateFrom":    CardDateFrom(instant),
+	} {
+		if got != want {
+			t.Errorf("%s got = %v, want %v", name, got.Time, want.Time)
+		}
+	}
+
+	// ParseExpToTime still returns the instant in UTC.
+	if got, err := ParseExpToTime(s); err != nil || got != instant.UTC() {
+		t.Errorf("ParseExpToTime() got = %v, %v, want %v", got, err, instant.UTC())
+	}
+}
+
+// TestCardDateNormalizationWindowEdge documents which zone decides the month:
+// UTC for ParseExpToTime and Parse, the offset written in the input for
+// CardDateFromString, ParseCardDate and the decoders of CardDate.
+func TestCardDateNormalizationWindowEdge(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want CardDate
+	}{
+		{"2050-12-31T23:30:00-02:00", CardDateFrom(time.Date(2050, 12, 1, 0, 0, 0, 0, time.UTC))},
+		{"2001-01-01T00:30:00+02:00", CardDateFrom(time.Date(2001, 1, 1, 0, 0, 0, 0, time.UTC))},
+	} {
+		if got, err := ParseExpToTime(tt.in); !errors.Is(err, ErrInvalidYear) {
+			t.Errorf("ParseExpToTime(%q) got = %v, %v, want %v", tt.in, got, err, ErrInvalidYear)
+		}
+		if got, err := DefaultCardDateParser.Parse(tt.in); !errors.Is(err, ErrInvalidYear) {
+			t.Errorf("Parse(%q) got = %v, %v, want %v", tt.in, got, err, ErrInvalidYear)
+		}
+
+		fromString, err := CardDateFromString(tt.in)
+		maybePanic(err)
+		parsed, err := DefaultCardDateParser.ParseCardDate(tt.in)
+		maybePanic(err)
+		var fromText CardDate
+		maybePanic(fromText.UnmarshalText([]byte(tt.in)))
+		for name, got := range map[string]CardDate{
+			"CardDateFromString": fromString,
+			"ParseCardDate":      parsed,
+			"UnmarshalText":      fromText,
+		} {
+			if got != tt.want {
+				t.Errorf("%s(%q) got = %v, want %v", name, tt.in, got.Time, tt.want.Time)
+			}
+		}
+	}
+}
+
+func TestCardDateCompare(t *testing.T) {
+	sep := CardDateFromMustString("09/25")
+	oct := CardDateFromMustString("10/25")
+	other := CardDate{Time: time.Date(2025, 9, 20, 0, 0, 0, 0, time.UTC), Valid: true}
+	null := CardDate{}
+
+	tests := []struct {
+		name string
+		a, b CardDate
+		want int
+	}{
+		{"same month", sep, other, 0},
+		{"before", sep, oct, -1},
+		{"after", oct, sep, 1},
+		{"null before valid", null, sep, -1},
+		{"valid after null", sep, null, 1},
+		{"null equal null", null, null, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.a.Compare(tt.b); got != tt.want {
+				t.Errorf("Compare() got = %d, want %d", got, tt.want)
+			}
+			if got := tt.a.Equal(tt.b); got != (tt.want == 0) {
+				t.Errorf("Equal() got = %v", got)
+			}
+			if got := tt.a.Before(tt.b); got != (tt.want < 0) {
+				t.Errorf("Before() got = %v", got)
+			}
+			if got := tt.a.After(tt.b); got != (tt.want > 0) {
+				t.Errorf("After() got = %v", got)
+			}
+		})
+	}
+}
+
+func TestCardDateYearMonth(t *testing.T) {
+	exp := CardDateFromMustString("09/25")
+	if exp.Year() != 2025 || exp.Month() != time.September {
+		t.Errorf("Year(), Month() got = %d, %v", exp.Year(), exp.Month())
+	}
+	if null := (CardDate{}); null.Year() != 0 || null.Month() != 0 {
+		t.Errorf("Year(), Month() of null got = %d, %v", null.Year(), null.Month())
+	}
+}
+
+func TestCardDateAddMonths(t *testing.T) {
+	exp := CardDateFromMustString("11/25")
+	tests := []struct {
+		months int
+		want   string
+	}{
+		{0, "11/25"},
+		{1, "12/25"},
+		{2, "01/26"},
+		{-11, "12/24"},
+		{25, "12/27"},
+	}
+	for _, tt := range tests {
+		if got := exp.AddMonths(tt.months); got != CardDateFromMustString(tt.want) {
+			t.Errorf("AddMonths(%d) got = %s, want %s", tt.months, got, tt.want)
+		}
+	}
+	if got := (CardDate{}).AddMonths(1); got.Valid {
+		t.Errorf("AddMonths() of null should stay null")
+	}
+}
+
+func TestCardDateRange(t *testing.T) {
+	got := CardDateRange(CardDateFromMustString("11/25"), CardDateFromMustString("02/26"))
+	want := []string{"11/25", "12/25", "01/26", "02/26"}
+	if len(got) != len(want) {
+		t.Fatalf("CardDateRange() got %d months, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i].String() != want[i] {
+			t.Errorf("CardDateRange()[%d] got = %s, want %s", i, got[i], want[i])
+		}
+	}
+
+	if got := CardDateRange(CardDateFromMustString("02/26"), CardDateFromMustString("11/25")); got != nil {
+		t.Errorf("CardDateRange() of reversed bounds got = %v, want nil", got)
+	}
+	if got := CardDateRange(CardDate{}, CardDateFromMustString("11/25")); got != nil {
+		t.Errorf("CardDateRange() from null got = %v, want nil", got)
+	}
+}
+
+func TestCardDateConversions(t *testing.T) {
+	exp := CardDateFromMustString("02/24")
+	if got, want := exp.FirstDay(), time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("FirstDay() got = %v, want %v", got, want)
+	}
+	if got, want := exp.LastDay(), time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("LastDay() got = %v, want %v", got, want)
+	}
+	if got := exp.NullTime(); !got.Valid || !got.Time.Equal(exp.FirstDay()) {
+		t.Errorf("NullTime() got = %v", got)
+	}
+
+	var null CardDate
+	if !null.FirstDay().IsZero() || !null.LastDay().IsZero() || null.NullTime().Valid {
+		t.Error("conversions of null CardDate should be zero")
+	}
+}
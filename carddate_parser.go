@@ -0,0 +1,406 @@
+package null
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	defaultMinYear = invalidYearFrom + 1
+	defaultMaxYear = invalidYearTo
+)
+
+// DefaultCardDateParser is used by ParseExpToTime, CardDateFromString and the
+// (un)marshalling methods of CardDate. It accepts expiry years 2001 to 2050.
+// Replace it to change the accepted window for the whole package.
+var DefaultCardDateParser = NewCardDateParser(defaultMinYear, defaultMaxYear)
+
+// CardDateParser parses card expiry dates and accepts only those whose year
+// falls inside a window. The window is either absolute (MinYear, MaxYear) or
+// relative to the current year (YearsBefore, YearsAfter). Two digit years are
+// resolved into the hundred years ending with the last accepted year.
+type CardDateParser struct {
+	// MinYear and MaxYear are absolute, inclusive bounds of the accepted year.
+	// They are ignored when a relative window is configured.
+	MinYear int
+	MaxYear int
+
+	// YearsBefore and YearsAfter define a window relative to the current year.
+	// A relative window is used if either of them is not zero.
+	YearsBefore int
+	YearsAfter  int
+
+	// Clock provides the reference time of a relative window.
+	// If nil, DefaultClock is used.
+	Clock Clock
+
+	// Layouts restricts the accepted formats. If empty, every format of
+	// ParseExpToTime is accepted and picked by the shape of the input.
+	// Otherwise an input must be valid in exactly one of the listed formats,
+	// or in several of them giving the same date; ErrAmbiguousFormat is
+	// returned if two of them give different dates. A format is either a
+	// fixed width pattern of Y, M, D and separators, e.g. MM/YY, YYMM or
+	// MMYYYY, or one of the names RFC3339, RFC3339Nano, RFC1123, RFC1123Z,
+	// RFC822, RFC822Z, RFC850, RubyDate, UnixDate and ANSIC.
+	Layouts []string
+
+	// Locales are tried in order when an input matches none of the formats
+	// of ParseExpToTime, to accept month names, e.g. "janv. 2027" or
+	// "27年3月". They are ignored when Layouts is set.
+	Locales []*CardDateLocale
+
+	// Policy, if set, validates every CardDate created through the parser.
+	// Parse and the other methods returning time.Time do not apply it.
+	Policy *CardDatePolicy
+}
+
+// NewCardDateParser creates a parser accepting the years from minYear to
+// maxYear, both inclusive.
+func NewCardDateParser(minYear, maxYear int) *CardDateParser {
+	return &CardDateParser{
+		MinYear: minYear,
+		MaxYear: maxYear,
+	}
+}
+
+// NewSlidingCardDateParser creates a parser accepting the years from
+// yearsBefore years before to yearsAfter years after the current year.
+// If clock is nil, DefaultClock is used.
+func NewSlidingCardDateParser(yearsBefore, yearsAfter int, clock Clock) *CardDateParser {
+	return &CardDateParser{
+		YearsBefore: yearsBefore,
+		YearsAfter:  yearsAfter,
+		Clock:       clock,
+	}
+}
+
+// NewStrictCardDateParser creates a parser accepting only the given layouts,
+// see CardDateParser.Layouts.
+func NewStrictCardDateParser(layouts ...string) *CardDateParser {
+	return &CardDateParser{Layouts: layouts}
+}
+
+// YearWindow returns the first and the last accepted year.
+func (p *CardDateParser) YearWindow() (from, to int) {
+	if p.YearsBefore != 0 || p.YearsAfter != 0 {
+		year := p.clock().Now().UTC().Year()
+		return year - p.YearsBefore, year + p.YearsAfter
+	}
+	if p.MinYear == 0 && p.MaxYear == 0 {
+		return defaultMinYear, defaultMaxYear
+	}
+	return p.MinYear, p.MaxYear
+}
+
+func (p *CardDateParser) clock() Clock {
+	if p.Clock != nil {
+		return p.Clock
+	}
+	return DefaultClock
+}
+
+// Parse takes a exp_date in one of the formats supported by ParseExpToTime
+// and returns it as time.Time in UTC. The short MM/YY style forms are scanned
+// by hand and do not allocate unless they fail, the RFC style forms are
+// detected by their shape and handed to time.Parse.
+// Errors are of type *CardDateError.
+func (p *CardDateParser) Parse(s string) (time.Time, error) {
+	return p.parse(s, true)
+}
+
+// parse implements Parse. If utc is false, a time with a zone offset keeps it
+// in its location, so the month of a CardDate is the one written in s.
+// The year window is checked on the time returned.
+func (p *CardDateParser) parse(s string, utc bool) (time.Time, error) {
+	if len(p.Layouts) > 0 {
+		return p.parseStrict(s, utc)
+	}
+	if format := shortFormat(s); format != "" {
+		return p.parseFixed(format, s)
+	}
+	if layout := detectLayout(s); layout != "" {
+		return p.parseLayout(layout, s, utc)
+	}
+	if t, ok, err := p.parseLocales(s); ok {
+		return t, err
+	}
+	return time.Time{}, &CardDateError{Input: s, Offset: -1, Err: ErrUnknownFormat}
+}
+
+// ParseCardDate parses s like Parse and returns a valid CardDate
+// accepted by the Policy of the parser. Unlike Parse, the month and the year
+// of a time with a zone offset are taken in that offset, not in UTC.
+func (p *CardDateParser) ParseCardDate(s string) (CardDate, error) {
+	t, err := p.parse(s, false)
+	if err == nil {
+		err = p.check(t)
+	}
+	if err != nil {
+		return CardDate{}, err
+	}
+	return CardDateFrom(t), nil
+}
+
+// parseStrict parses s with every one of p.Layouts. A component error of a
+// layout is preferred over a mismatch of the shape when nothing succeeds.
+func (p *CardDateParser) parseStrict(s string, utc bool) (time.Time, error) {
+	var (
+		found       time.Time
+		foundLayout string
+		firstErr    error
+	)
+	for _, layout := range p.Layouts {
+		t, err := p.parseNamed(layout, s, utc)
+		if err != nil {
+			if firstErr == nil || errors.Is(firstErr, ErrUnknownFormat) && !errors.Is(err, ErrUnknownFormat) {
+				firstErr = err
+			}
+			continue
+		}
+		if foundLayout != "" && !t.Equal(found) {
+			return time.Time{}, &CardDateError{
+				Input:  s,
+				Format: foundLayout + " or " + layout,
+				Offset: -1,
+				Err:    ErrAmbiguousFormat,
+			}
+		}
+		if foundLayout == "" {
+			found, foundLayout = t, layout
+		}
+	}
+	if foundLayout != "" {
+		return found, nil
+	}
+	if len(p.Layouts) > 1 {
+		if e, ok := firstErr.(*CardDateError); ok && errors.Is(e, ErrUnknownFormat) {
+			e.Format, e.Offset = "", -1
+		}
+	}
+	return time.Time{}, firstErr
+}
+
+// parseNamed parses s with a single format named like in CardDateParser.Layouts.
+func (p *CardDateParser) parseNamed(name, s string, utc bool) (time.Time, error) {
+	if layout, ok := namedLayouts[name]; ok {
+		if detectLayout(s) != layout {
+			return time.Time{}, &CardDateError{Input: s, Format: name, Offset: -1, Err: ErrUnknownFormat}
+		}
+		return p.parseLayout(layout, s, utc)
+	}
+	return p.parseFixed(name, s)
+}
+
+// parseFixed parses s according to a fixed width format made of the letters
+// Y, M and D standing for the digits of the year, month and day, and of
+// literal separators, e.g. MM/YY or YYMMDD. A four letter year is taken as is,
+// a two letter one is resolved with expandYear. Without D, the day is 1.
+func (p *CardDateParser) parseFixed(format, s string) (time.Time, error) {
+	if len(s) != len(format) {
+		return time.Time{}, &CardDateError{Input: s, Format: format, Offset: -1, Err: ErrUnknownFormat}
+	}
+	var (
+		value  = [3]int{0, 0, 1}
+		at     = [3]int{-1, -1, -1}
+		bad    = [3]int{-1, -1, -1}
+		digits = [3]int{}
+	)
+	for i := 0; i < len(format); i++ {
+		c := componentYear
+		switch format[i] {
+		case 'Y':
+		case 'M':
+			c = componentMonth
+		case 'D':
+			c = componentDay
+		default:
+			if s[i] != format[i] {
+				return time.Time{}, &CardDateError{Input: s, Format: format, Offset: i, Err: ErrUnknownFormat}
+			}
+			continue
+		}
+		if at[c] < 0 {
+			at[c], value[c] = i, 0
+		}
+		digits[c]++
+		if !isDigit(s[i]) {
+			if bad[c] < 0 {
+				bad[c] = i
+			}
+			continue
+		}
+		value[c] = value[c]*10 + int(s[i]-'0')
+	}
+
+	if bad[componentMonth] >= 0 {
+		return time.Time{}, newComponentError(s, format, componentMonth, bad[componentMonth])
+	}
+	if value[componentMonth] < 1 || value[componentMonth] > 12 {
+		return time.Time{}, newComponentError(s, format, componentMonth, at[componentMonth])
+	}
+	if bad[componentYear] >= 0 {
+		return time.Time{}, newComponentError(s, format, componentYear, bad[componentYear])
+	}
+	if bad[componentDay] >= 0 {
+		return time.Time{}, newComponentError(s, format, componentDay, bad[componentDay])
+	}
+	t, err := p.date(value[componentYear], digits[componentYear] == 2, value[componentMonth], value[componentDay])
+	switch err {
+	case nil:
+		return t, nil
+	case ErrInvalidYear:
+		return t, newComponentError(s, format, componentYear, at[componentYear])
+	default:
+		return t, newComponentError(s, format, componentDay, at[componentDay])
+	}
+}
+
+// date builds the time of a card date and checks it against the window.
+// If short is true, year has only two digits.
+func (p *CardDateParser) date(year int, short bool, month, day int) (time.Time, error) {
+	if month < 1 || month > 12 {
+		return time.Time{}, ErrInvalidMonth
+	}
+	from, to := p.YearWindow()
+	if short {
+		year = expandYear(year, to)
+	}
+	if year < from || year > to {
+		return time.Time{}, ErrInvalidYear
+	}
+	if day < 1 || day > daysIn(year, time.Month(month)) {
+		return time.Time{}, ErrInvalidDay
+	}
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC), nil
+}
+
+// parseLayout parses value with a layout of the time package. If utc is true,
+// the time is converted to UTC before its year is checked.
+func (p *CardDateParser) parseLayout(layout, value string, utc bool) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil {
+		return time.Time{}, newLayoutError(layout, value, err)
+	}
+	from, to := p.YearWindow()
+	if !strings.Contains(layout, "2006") {
+		t = withYear(t, expandYear(t.Year()%100, to))
+	}
+	if utc {
+		t = t.UTC()
+	}
+	if t.Year() < from || t.Year() > to {
+		return time.Time{}, &CardDateError{
+			Input:     value,
+			Format:    layoutName(layout),
+			Offset:    yearOffset(layout, value),
+			Component: "year",
+			Err:       ErrInvalidYear,
+		}
+	}
+	return t, nil
+}
+
+// expandYear resolves a two digit year into the hundred years ending with
+// the year last.
+func expandYear(yy, last int) int {
+	year := last - last%100 + yy
+	if year > last {
+		year -= 100
+	}
+	return year
+}
+
+func withYear(t time.Time, year int) time.Time {
+	return time.Date(year, t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// shortFormat returns the MM/YY style format s is written in,
+// or an empty string if it is none of them.
+func shortFormat(s string) string {
+	if len(s) < 4 || !isDigit(s[0]) {
+		return ""
+	}
+	switch len(s) {
+	case 4:
+		return "MMYY"
+	case 6:
+		return "MMYYYY"
+	case 5:
+		switch s[2] {
+		case '/':
+			return "MM/YY"
+		case '-':
+			return "MM-YY"
+		}
+	case 7:
+		switch s[2] {
+		case '/':
+			return "MM/YYYY"
+		case '-':
+			return "MM-YYYY"
+		}
+	}
+	return ""
+}
+
+// detectLayout returns the RFC style layout s is written in,
+// or an empty string if it is none of them.
+func detectLayout(s string) string {
+	if len(s) < 19 {
+		return ""
+	}
+	switch {
+	case isDigit(s[0]) && s[4] == '-':
+		if s[10] != 'T' && s[10] != 't' {
+			return ""
+		}
+		if len(s) > 19 && s[19] == '.' {
+			return time.RFC3339Nano
+		}
+		return time.RFC3339
+	case isDigit(s[0]) && s[2] == ' ':
+		if isSign(lastField(s)) {
+			return time.RFC822Z
+		}
+		return time.RFC822
+	case isLetter(s[0]):
+		comma := strings.IndexByte(s, ',')
+		switch {
+		case comma == 3:
+			if isSign(lastField(s)) {
+				return time.RFC1123Z
+			}
+			return time.RFC1123
+		case comma >= 6 && comma <= 9:
+			return time.RFC850
+		case comma == -1 && s[3] == ' ':
+			zone := lastField(strings.TrimRight(s[:strings.LastIndexByte(s, ' ')], " "))
+			switch {
+			case isSign(zone):
+				return time.RubyDate
+			case zone != "" && isDigit(zone[0]):
+				return time.ANSIC
+			}
+			return time.UnixDate
+		}
+	}
+	return ""
+}
+
+// lastField returns the part of s after its last space.
+func lastField(s string) string {
+	return s[strings.LastIndexByte(s, ' ')+1:]
+}
+
+func isSign(s string) bool {
+	return s != "" && (s[0] == '+' || s[0] == '-')
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
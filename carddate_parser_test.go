@@ -0,0 +1,443 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCardDateParser_YearWindow(t *testing.T) {
+	now := FixedClock(time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name   string
+		parser *CardDateParser
+		from   int
+		to     int
+	}{
+		{
+			name:   "Zero value",
+			parser: &CardDateParser{},
+			from:   2001,
+			to:     2050,
+		},
+		{
+			name:   "Absolute",
+			parser: NewCardDateParser(2010, 2070),
+			from:   2010,
+			to:     2070,
+		},
+		{
+			name:   "Sliding",
+			parser: NewSlidingCardDateParser(20, 25, now),
+			from:   2006,
+			to:     2051,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			from, to := test.parser.YearWindow()
+			if from != test.from || to != test.to {
+				t.Errorf("YearWindow() got = %d-%d, want %d-%d", from, to, test.from, test.to)
+			}
+		})
+	}
+}
+
+func TestCardDateParser_Parse(t *testing.T) {
+	now := FixedClock(time.Date(2040, 3, 15, 0, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name   string
+		parser *CardDateParser
+		exp    string
+		want   time.Time
+		err    error
+	}{
+		{
+			name:   "Sliding window accepts 2060",
+			parser: NewSlidingCardDateParser(20, 25, now),
+			exp:    "09/60",
+			want:   time.Date(2060, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Sliding window accepts 2060 with four digit year",
+			parser: NewSlidingCardDateParser(20, 25, now),
+			exp:    "09/2060",
+			want:   time.Date(2060, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Sliding window rejects 2066",
+			parser: NewSlidingCardDateParser(20, 25, now),
+			exp:    "09/66",
+			err:    ErrInvalidYear,
+		},
+		{
+			name:   "Sliding window rejects 2019",
+			parser: NewSlidingCardDateParser(20, 25, now),
+			exp:    "0919",
+			err:    ErrInvalidYear,
+		},
+		{
+			name:   "Absolute window pivot",
+			parser: NewCardDateParser(1980, 2030),
+			exp:    "09-85",
+			want:   time.Date(1985, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:   "Absolute window pivot in RFC822",
+			parser: NewCardDateParser(2040, 2080),
+			exp:    "02 Nov 75 15:04 -0000",
+			want:   time.Date(2075, 11, 2, 15, 4, 0, 0, time.UTC),
+		},
+		{
+			name:   "Absolute window upper bound",
+			parser: NewCardDateParser(2040, 2080),
+			exp:    "01/2081",
+			err:    ErrInvalidYear,
+		},
+		{
+			name:   "Invalid month",
+			parser: NewCardDateParser(2040, 2080),
+			exp:    "13/50",
+			err:    ErrInvalidMonth,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.parser.Parse(test.exp)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Parse() error = %v, want %v", err, test.err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("Parse() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCardDateParser_ParseCardDate(t *testing.T) {
+	parser := NewCardDateParser(2040, 2080)
+	got, err := parser.ParseCardDate("07/75")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !got.Valid || got.String() != "07/75" || got.Time.Year() != 2075 {
+		t.Errorf("ParseCardDate() got = %v (%d)", got, got.Time.Year())
+	}
+
+	if _, err := parser.ParseCardDate("07/35"); !errors.Is(err, ErrInvalidYear) {
+		t.Errorf("ParseCardDate() error = %v, want %v", err, ErrInvalidYear)
+	}
+}
+
+func TestDefaultCardDateParser(t *testing.T) {
+	old := DefaultCardDateParser
+	defer func() { DefaultCardDateParser = old }()
+
+	if _, err := CardDateFromString("09/2055"); !errors.Is(err, ErrInvalidYear) {
+		t.Fatalf("CardDateFromString() error = %v, want %v", err, ErrInvalidYear)
+	}
+
+	DefaultCardDateParser = NewCardDateParser(2001, 2060)
+	got, err := CardDateFromString("09/2055")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Time.Year() != 2055 {
+		t.Errorf("CardDateFromString() year = %d, want 2055", got.Time.Year())
+	}
+}
+
+func TestDetectLayout(t *testing.T) {
+	tests := []struct {
+		exp    string
+		layout string
+	}{
+		{exp: "2023-11-30T00:00:00Z", layout: time.RFC3339},
+		{exp: "2023-11-30T00:00:00+07:00", layout: time.RFC3339},
+		{exp: "2023-11-30T00:00:00.999999999Z", layout: time.RFC3339Nano},
+		{exp: "2025-09-01T00:00:00", layout: time.RFC3339},
+		{exp: "Mon, 30 Nov 2023 00:00:00 -0700", layout: time.RFC1123Z},
+		{exp: "Mon, 02 Nov 2023 15:04:05 MST", layout: time.RFC1123},
+		{exp: "Monday, 02-Nov-23 15:04:05 MST", layout: time.RFC850},
+		{exp: "02 Nov 23 15:04 -0700", layout: time.RFC822Z},
+		{exp: "02 Nov 23 15:04 MST", layout: time.RFC822},
+		{exp: "Mon Nov 02 15:04:05 -0700 2023", layout: time.RubyDate},
+		{exp: "Mon Nov 2 15:04:05 MST 2023", layout: time.UnixDate},
+		{exp: "Mon Nov  2 15:04:05 MST 2023", layout: time.UnixDate},
+		{exp: "Mon Nov 22 15:04:05 2023", layout: time.ANSIC},
+		{exp: "invalidformat", layout: ""},
+		{exp: "2023-11-30 00:00:00Z", layout: ""},
+		{exp: "a very long but invalid format", layout: ""},
+	}
+	for _, test := range tests {
+		t.Run(test.exp, func(t *testing.T) {
+			if got := detectLayout(test.exp); got != test.layout {
+				t.Errorf("detectLayout() got = %q, want %q", got, test.layout)
+			}
+		})
+	}
+}
+
+func TestCardDateParser_ParseShortAllocs(t *testing.T) {
+	for _, exp := range []string{"0923", "09/23", "09-23", "092023", "09/2023", "09-2023"} {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = DefaultCardDateParser.Parse(exp)
+		})
+		if allocs != 0 {
+			t.Errorf("Parse(%q) allocates %v times", exp, allocs)
+		}
+	}
+}
+
+func TestCardDateParser_ParseMatchesRegexParser(t *testing.T) {
+	for _, exp := range benchmarkCardDates {
+		want, wantErr := regexStringToTime(exp)
+		got, err := stringToTime(exp)
+		if cardDateErrorCause(err) != cardDateErrorCause(wantErr) || !got.Equal(want) {
+			t.Errorf("stringToTime(%q) = %v, %v; regex parser = %v, %v", exp, got, err, want, wantErr)
+		}
+	}
+}
+
+func cardDateErrorCause(err error) error {
+	var e *CardDateError
+	if errors.As(err, &e) {
+		return e.Err
+	}
+	return err
+}
+
+var benchmarkCardDates = []string{
+	"0923",
+	"09/23",
+	"09-23",
+	"092023",
+	"09/2023",
+	"09-2023",
+	"2023-11-30T00:00:00Z",
+	"2023-11-30T00:00:00.999999999Z",
+	"Mon, 30 Nov 2023 00:00:00 -0700",
+	"Mon, 02 Nov 2023 15:04:05 MST",
+	"Monday, 02-Nov-23 15:04:05 MST",
+	"02 Nov 23 15:04 -0700",
+	"02 Nov 23 15:04 MST",
+	"Mon Nov 02 15:04:05 -0700 2023",
+	"Mon Nov 2 15:04:05 MST 2023",
+	"Mon Nov 22 15:04:05 2023",
+	"13/22",
+	"01/55",
+	"invalidformat",
+}
+
+func BenchmarkParseExpToTime(b *testing.B) {
+	for _, exp := range benchmarkCardDates {
+		b.Run(exp, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = ParseExpToTime(exp)
+			}
+		})
+	}
+}
+
+func BenchmarkRegexParseExpToTime(b *testing.B) {
+	for _, exp := range benchmarkCardDates {
+		b.Run(exp, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, _ = regexStringToTime(exp)
+			}
+		})
+	}
+}
+
+// The regexp based implementation ParseExpToTime used before the scanner,
+// kept as a reference for benchmarks.
+var (
+	cardDateRFC3339Regex     = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}[Tt][0-9]{2}:[0-9]{2}:[0-9]{2}[Zz+-:0-9]{1,6}$)")
+	cardDateRFC3339NanoRegex = regexp.MustCompile("^([0-9]{4}-[0-9]{2}-[0-9]{2}[Tt][0-9]{2}:[0-9]{2}:[0-9]{2}.[0-9]{7,9}[Zz+-:0-9]{1,6}$)")
+	cardDateRFC1123Regex     = regexp.MustCompile("^([A-Za-z]{3}, [0-9]{2} [A-Za-z]{3} [0-9]{4} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Za-z]{3,4}$)")
+	cardDateRFC1123ZRegex    = regexp.MustCompile("^([A-Za-z]{3}, [0-9]{2} [A-Za-z]{3} [0-9]{4} [0-9]{2}:[0-9]{2}:[0-9]{2} [-+]{1}[0-9]{4}$)")
+	cardDateRFC822ZRegex     = regexp.MustCompile("^([0-9]{2} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2} [-+]{1}[0-9]{4}$)")
+	cardDateRFC822Regex      = regexp.MustCompile("^([0-9]{2} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2} [A-Za-z]{3,4}$)")
+	cardDateRFC850Regex      = regexp.MustCompile("^([A-Za-z]{6,9}, [0-9]{2}-[A-Za-z]{3}-[0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Z]{3,4}$)")
+	cardDateRubyFormatRegex  = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9]{2} [0-9]{2}:[0-9]{2}:[0-9]{2} [+-][0-9]{4} [0-9]{4}$)")
+	cardDateUnixFormatRegex  = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9_ ]{1,2} [0-9]{2}:[0-9]{2}:[0-9]{2} [A-Za-z]{3,4} [0-9]{4}$)")
+	cardDateANSICFormatRegex = regexp.MustCompile("^([A-Za-z]{3} [A-Za-z]{3} [0-9_ ]{1,2} [0-9]{2}:[0-9]{2}:[0-9]{2} [0-9]{4}$)")
+)
+
+func regexStringToTime(s string) (time.Time, error) {
+	switch {
+	case cardDateRFC3339Regex.MatchString(s):
+		return timeParser(time.RFC3339, s)
+	case cardDateRFC3339NanoRegex.MatchString(s):
+		return timeParser(time.RFC3339Nano, s)
+	case cardDateRFC1123ZRegex.MatchString(s):
+		return timeParser(time.RFC1123Z, s)
+	case cardDateRFC1123Regex.MatchString(s):
+		return timeParser(time.RFC1123, s)
+	case cardDateRFC850Regex.MatchString(s):
+		return timeParser(time.RFC850, s)
+	case cardDateRFC822Regex.MatchString(s):
+		return timeParser(time.RFC822, s)
+	case cardDateRFC822ZRegex.MatchString(s):
+		return timeParser(time.RFC822Z, s)
+	case cardDateRubyFormatRegex.MatchString(s):
+		return timeParser(time.RubyDate, s)
+	case cardDateUnixFormatRegex.MatchString(s):
+		return timeParser(time.UnixDate, s)
+	case cardDateANSICFormatRegex.MatchString(s):
+		return timeParser(time.ANSIC, s)
+	case len(s) == 4:
+		return timeParser("0106", s)
+	case len(s) == 6:
+		return timeParser("012006", s)
+	case len(s) == 5 && strings.Contains(s, "/"):
+		return timeParser("01/06", s)
+	case len(s) == 5 && strings.Contains(s, "-"):
+		return timeParser("01-06", s)
+	case len(s) == 7 && strings.Contains(s, "/"):
+		return timeParser("01/2006", s)
+	case len(s) == 7 && strings.Contains(s, "-"):
+		return timeParser("01-2006", s)
+	}
+
+	return time.Time{}, ErrUnknownFormat
+}
+
+// timeParser is the time.Parse based parser of regexStringToTime.
+func timeParser(layout, value string) (time.Time, error) {
+	t, err := time.Parse(layout, value)
+	if err != nil && strings.Contains(err.Error(), "month out of range") {
+		return t, ErrInvalidMonth
+	}
+	if err != nil {
+		return time.Time{}, err
+	}
+	t = t.UTC()
+	if t.Year() <= invalidYearFrom || t.Year() > invalidYearTo {
+		return time.Time{}, ErrInvalidYear
+	}
+	return t, err
+}
+
+func TestCardDateParser_Strict(t *testing.T) {
+	tests := []struct {
+		name    string
+		layouts []string
+		exp     string
+		want    time.Time
+		err     error
+		format  string
+	}{
+		{
+			name:    "Only MM/YY accepts MM/YY",
+			layouts: []string{"MM/YY"},
+			exp:     "09/25",
+			want:    time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Only MM/YY rejects MM/YYYY",
+			layouts: []string{"MM/YY"},
+			exp:     "09/2025",
+			err:     ErrUnknownFormat,
+			format:  "MM/YY",
+		},
+		{
+			name:    "Only MM/YY rejects RFC3339",
+			layouts: []string{"MM/YY"},
+			exp:     "2025-09-30T00:00:00Z",
+			err:     ErrUnknownFormat,
+			format:  "MM/YY",
+		},
+		{
+			name:    "Only YYMM reads 2509 as September 2025",
+			layouts: []string{"YYMM"},
+			exp:     "2509",
+			want:    time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Only YYMM reports the month",
+			layouts: []string{"YYMM"},
+			exp:     "0925",
+			err:     ErrInvalidMonth,
+			format:  "YYMM",
+		},
+		{
+			name:    "MMYY or YYMM resolved by validity",
+			layouts: []string{"MMYY", "YYMM"},
+			exp:     "2509",
+			want:    time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "MMYY or YYMM ambiguous",
+			layouts: []string{"MMYY", "YYMM"},
+			exp:     "1011",
+			err:     ErrAmbiguousFormat,
+			format:  "MMYY or YYMM",
+		},
+		{
+			name:    "MMYY or YYMM same date",
+			layouts: []string{"MMYY", "YYMM"},
+			exp:     "1010",
+			want:    time.Date(2010, 10, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "No layout matches the shape",
+			layouts: []string{"MM/YY", "YYMM"},
+			exp:     "09-25",
+			err:     ErrUnknownFormat,
+		},
+		{
+			name:    "Named RFC layout",
+			layouts: []string{"MM/YY", "RFC3339"},
+			exp:     "2025-09-30T00:00:00Z",
+			want:    time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:    "Named RFC layout rejects other RFC layouts",
+			layouts: []string{"RFC3339"},
+			exp:     "Mon, 02 Nov 2023 15:04:05 MST",
+			err:     ErrUnknownFormat,
+			format:  "RFC3339",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := NewStrictCardDateParser(test.layouts...).Parse(test.exp)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Parse() error = %v, want %v", err, test.err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("Parse() got = %v, want %v", got, test.want)
+			}
+			if err == nil {
+				return
+			}
+			var e *CardDateError
+			if !errors.As(err, &e) {
+				t.Fatalf("error should be *CardDateError, instead of %T", err)
+			}
+			if e.Format != test.format {
+				t.Errorf("Format got = %q, want %q", e.Format, test.format)
+			}
+		})
+	}
+}
+
+func TestCardDate_UnmarshalJSONStrict(t *testing.T) {
+	old := DefaultCardDateParser
+	defer func() { DefaultCardDateParser = old }()
+	DefaultCardDateParser = NewStrictCardDateParser("YYMM")
+
+	var s testStruct
+	if err := json.Unmarshal([]byte(`{"expiration_date":"2509"}`), &s); err != nil {
+		t.Fatal(err)
+	}
+	if s.CardDate.String() != "09/25" {
+		t.Errorf("CardDate should be 09/25, instead of %s", s.CardDate.String())
+	}
+}
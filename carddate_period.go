@@ -0,0 +1,178 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrCardPeriodOrder is returned when the start month of a CardPeriod is
+// after its expiry month.
+var ErrCardPeriodOrder = errors.New("card valid from date is after expiry date")
+
+// CardPeriod is a nullable validity period of a card: the "valid from" month
+// and the expiry month, either of which may be null. Both ends are parsed like
+// CardDate, From is not checked against the Policy of DefaultCardDateParser.
+// It is serialized as {"from":"09/23","expiry":"09/27"}, as JSON and in SQL.
+// To store the period in two columns, use From and Expiry directly.
+type CardPeriod struct {
+	From   CardDate
+	Expiry CardDate
+	Valid  bool
+}
+
+// NewCardPeriod creates a new CardPeriod without checking the order of its ends.
+func NewCardPeriod(from, expiry CardDate, valid bool) CardPeriod {
+	return CardPeriod{
+		From:   from,
+		Expiry: expiry,
+		Valid:  valid,
+	}
+}
+
+// CardPeriodFrom creates a new valid CardPeriod, or returns ErrCardPeriodOrder
+// if from is after expiry.
+func CardPeriodFrom(from, expiry CardDate) (CardPeriod, error) {
+	p := NewCardPeriod(from, expiry, true)
+	if err := p.check(); err != nil {
+		return CardPeriod{}, err
+	}
+	return p, nil
+}
+
+// ParseCardPeriod parses the start and the expiry month of a card with
+// DefaultCardDateParser. An empty from gives a null From.
+func ParseCardPeriod(from, expiry string) (CardPeriod, error) {
+	return DefaultCardDateParser.ParseCardPeriod(from, expiry)
+}
+
+// ParseCardPeriod parses the start and the expiry month of a card.
+// An empty from gives a null From. The Policy of the parser is applied
+// to the expiry only.
+func (p *CardDateParser) ParseCardPeriod(from, expiry string) (CardPeriod, error) {
+	var start CardDate
+	if from != "" {
+		t, err := p.parse(from, false)
+		if err != nil {
+			return CardPeriod{}, err
+		}
+		start = CardDateFrom(t)
+	}
+	end, err := p.ParseCardDate(expiry)
+	if err != nil {
+		return CardPeriod{}, err
+	}
+	return CardPeriodFrom(start, end)
+}
+
+// IsActive reports whether the card can be used at now: the period is valid,
+// now is not before the start of From, and Expiry is not expired at now.
+// The months are evaluated in the location of now. A null From has no start,
+// a null Expiry is always expired.
+func (p CardPeriod) IsActive(now time.Time) bool {
+	if !p.Valid || p.Expiry.IsExpired(now) {
+		return false
+	}
+	if !p.From.Valid {
+		return true
+	}
+	start := time.Date(p.From.Year(), p.From.Month(), 1, 0, 0, 0, 0, now.Location())
+	return !now.Before(start)
+}
+
+type cardPeriodJSON struct {
+	From   json.RawMessage `json:"from"`
+	Expiry json.RawMessage `json:"expiry"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (p CardPeriod) MarshalJSON() ([]byte, error) {
+	if !p.Valid {
+		return NullBytes, nil
+	}
+	from, _ := p.From.MarshalJSON()
+	expiry, _ := p.Expiry.MarshalJSON()
+	return json.Marshal(cardPeriodJSON{From: from, Expiry: expiry})
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+// Missing ends are null.
+func (p *CardPeriod) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		*p = CardPeriod{}
+		return nil
+	}
+
+	var raw cardPeriodJSON
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	var v CardPeriod
+	if len(raw.From) > 0 {
+		if err := v.From.unmarshalJSON(raw.From, false); err != nil {
+			return err
+		}
+	}
+	if len(raw.Expiry) > 0 {
+		if err := v.Expiry.UnmarshalJSON(raw.Expiry); err != nil {
+			return err
+		}
+	}
+	v.Valid = true
+	if err := v.check(); err != nil {
+		return err
+	}
+	*p = v
+	return nil
+}
+
+// IsZero returns true for a null CardPeriod, for potential future omitempty support.
+func (p CardPeriod) IsZero() bool {
+	return !p.Valid
+}
+
+// Scan implements the Scanner interface.
+// It accepts the JSON object written by Value as string or []byte.
+func (p *CardPeriod) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case string:
+		return p.UnmarshalJSON([]byte(x))
+	case []byte:
+		return p.UnmarshalJSON(x)
+	case nil:
+		*p = CardPeriod{}
+		return nil
+	}
+	p.Valid = false
+	return fmt.Errorf("null: cannot scan type %T into null.CardPeriod: %v", value, value)
+}
+
+// Value implements the driver Valuer interface.
+func (p CardPeriod) Value() (driver.Value, error) {
+	if !p.Valid {
+		return nil, nil
+	}
+	data, err := p.MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return string(data), nil
+}
+
+// String returns the period as FROM-EXPIRY, e.g. 09/23-09/27, or "null".
+func (p CardPeriod) String() string {
+	if !p.Valid {
+		return "null"
+	}
+	return p.From.String() + "-" + p.Expiry.String()
+}
+
+func (p CardPeriod) check() error {
+	if p.From.Valid && p.Expiry.Valid && p.From.After(p.Expiry) {
+		return fmt.Errorf("null: card period %s: %w", p, ErrCardPeriodOrder)
+	}
+	return nil
+}
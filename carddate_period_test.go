@@ -0,0 +1,111 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseCardPeriod(t *testing.T) {
+	p, err := ParseCardPeriod("09/23", "09/27")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !p.Valid || p.From != CardDateFromMustString("09/23") || p.Expiry != CardDateFromMustString("09/27") {
+		t.Errorf("ParseCardPeriod() got = %v", p)
+	}
+
+	p, err = ParseCardPeriod("", "09/27")
+	if err != nil || p.From.Valid || !p.Expiry.Valid {
+		t.Errorf("ParseCardPeriod() without start got = %v, %v", p, err)
+	}
+
+	if _, err := ParseCardPeriod("09/27", "09/23"); !errors.Is(err, ErrCardPeriodOrder) {
+		t.Errorf("ParseCardPeriod() error = %v, want %v", err, ErrCardPeriodOrder)
+	}
+	if _, err := ParseCardPeriod("13/23", "09/27"); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("ParseCardPeriod() error = %v, want %v", err, ErrInvalidMonth)
+	}
+	if _, err := ParseCardPeriod("09/23", ""); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("ParseCardPeriod() error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestCardPeriodIsActive(t *testing.T) {
+	p, _ := ParseCardPeriod("09/23", "09/27")
+	tests := []struct {
+		name   string
+		period CardPeriod
+		now    time.Time
+		want   bool
+	}{
+		{"before start", p, time.Date(2023, 8, 31, 23, 0, 0, 0, time.UTC), false},
+		{"start month", p, time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC), true},
+		{"expiry month", p, time.Date(2027, 9, 30, 23, 0, 0, 0, time.UTC), true},
+		{"expired", p, time.Date(2027, 10, 1, 0, 0, 0, 0, time.UTC), false},
+		{"no start", NewCardPeriod(CardDate{}, p.Expiry, true), time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC), true},
+		{"no expiry", NewCardPeriod(p.From, CardDate{}, true), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+		{"null", CardPeriod{}, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.period.IsActive(tt.now); got != tt.want {
+				t.Errorf("IsActive() got = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCardPeriodJSON(t *testing.T) {
+	p, _ := ParseCardPeriod("09/23", "09/27")
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"from":"09/23","expiry":"09/27"}`, "period json")
+
+	var got CardPeriod
+	if err := json.Unmarshal(data, &got); err != nil || got != p {
+		t.Errorf("UnmarshalJSON() got = %v, %v, want %v", got, err, p)
+	}
+
+	data, err = json.Marshal(NewCardPeriod(CardDate{}, p.Expiry, true))
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"from":null,"expiry":"09/27"}`, "period without start json")
+
+	data, err = json.Marshal(CardPeriod{})
+	maybePanic(err)
+	assertJSONEquals(t, data, "null", "null period json")
+
+	if err := json.Unmarshal([]byte(`{"expiry":"09/27"}`), &got); err != nil || got.From.Valid || !got.Valid {
+		t.Errorf("UnmarshalJSON() without start got = %v, %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(`{"from":"09/27","expiry":"09/23"}`), &got); !errors.Is(err, ErrCardPeriodOrder) {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, ErrCardPeriodOrder)
+	}
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || got.Valid {
+		t.Errorf("UnmarshalJSON() of null got = %v, %v", got, err)
+	}
+}
+
+func TestCardPeriodScanValue(t *testing.T) {
+	p, _ := ParseCardPeriod("09/23", "09/27")
+	v, err := p.Value()
+	maybePanic(err)
+	var got CardPeriod
+	if err := got.Scan(v); err != nil || got != p {
+		t.Errorf("Scan(%v) got = %v, %v, want %v", v, got, err, p)
+	}
+	if err := got.Scan([]byte(v.(string))); err != nil || got != p {
+		t.Errorf("Scan([]byte) got = %v, %v, want %v", got, err, p)
+	}
+
+	if err := got.Scan(nil); err != nil || got.Valid {
+		t.Errorf("Scan(nil) got = %v, %v", got, err)
+	}
+	if v, err := got.Value(); v != nil || err != nil {
+		t.Errorf("Value() of null got = %v, %v", v, err)
+	}
+	if err := got.Scan(int64(1)); err == nil {
+		t.Error("Scan(int64) should fail")
+	}
+}
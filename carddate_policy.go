@@ -0,0 +1,116 @@
+package null
+
+import (
+	"errors"
+	"time"
+)
+
+// vars
+var (
+	ErrCardDateNull          = errors.New("card date is null")
+	ErrCardDateNotNormalized = errors.New("card date is not the first instant of a month")
+	ErrCardExpired           = errors.New("card is expired")
+	ErrCardDateTooFarAhead   = errors.New("card date is too far ahead")
+)
+
+// CardDatePolicy validates CardDate values. The zero value accepts every
+// CardDate. Set it as CardDateParser.Policy to validate every CardDate
+// created by the parser, including UnmarshalJSON, UnmarshalText and Scan
+// when the parser is DefaultCardDateParser.
+type CardDatePolicy struct {
+	// RejectNull rejects a null CardDate.
+	RejectNull bool
+	// RequireFirstOfMonth rejects a CardDate that is not the first instant
+	// of a month in UTC, as stored by the constructors and decoders of
+	// CardDate. Only a CardDate whose Time was set directly can break it.
+	RequireFirstOfMonth bool
+	// RejectExpired rejects a CardDate expired according to Clock,
+	// keeping it valid for GracePeriod after its expiry month.
+	RejectExpired bool
+	GracePeriod   time.Duration
+	// MaxYearsAhead rejects a CardDate more than MaxYearsAhead years after
+	// the current month. Zero means no limit.
+	MaxYearsAhead int
+	// Clock provides the current time. If nil, DefaultClock is used.
+	Clock Clock
+}
+
+// CardDatePolicyError describes why a CardDate was rejected by a CardDatePolicy.
+// It unwraps to ErrCardDateNull, ErrCardDateNotNormalized, ErrCardExpired or
+// ErrCardDateTooFarAhead.
+type CardDatePolicyError struct {
+	Date CardDate
+	// Limit is the instant the CardDate was compared with, if any:
+	// the end of the grace period, the latest accepted month or the
+	// normalized CardDate.
+	Limit time.Time
+	Err   error
+}
+
+// Error implements error.
+func (e *CardDatePolicyError) Error() string {
+	msg := "null: invalid card date " + e.Date.String() + ": " + e.Err.Error()
+	if !e.Limit.IsZero() {
+		msg += " (limit " + e.Limit.Format(time.RFC3339) + ")"
+	}
+	return msg
+}
+
+// Unwrap returns the underlying cause.
+func (e *CardDatePolicyError) Unwrap() error {
+	return e.Err
+}
+
+// Validate checks t against the policy and returns a *CardDatePolicyError
+// for the first rule it breaks.
+func (p CardDatePolicy) Validate(t CardDate) error {
+	if !t.Valid {
+		if p.RejectNull {
+			return &CardDatePolicyError{Date: t, Err: ErrCardDateNull}
+		}
+		return nil
+	}
+
+	if p.RequireFirstOfMonth {
+		u := t.Time.UTC()
+		first := time.Date(u.Year(), u.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if !t.Time.Equal(first) {
+			return &CardDatePolicyError{Date: t, Limit: first, Err: ErrCardDateNotNormalized}
+		}
+	}
+
+	if !p.RejectExpired && p.MaxYearsAhead <= 0 {
+		return nil
+	}
+	clock := p.Clock
+	if clock == nil {
+		clock = DefaultClock
+	}
+	now := clock.Now()
+
+	if p.RejectExpired && t.IsExpiredWithGrace(now, p.GracePeriod) {
+		limit := t.ExpiresAt(now.Location()).Add(p.GracePeriod)
+		return &CardDatePolicyError{Date: t, Limit: limit, Err: ErrCardExpired}
+	}
+	if p.MaxYearsAhead > 0 && t.MonthsUntilExpiry(now) > 12*p.MaxYearsAhead {
+		limit := time.Date(now.Year()+p.MaxYearsAhead, now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return &CardDatePolicyError{Date: t, Limit: limit, Err: ErrCardDateTooFarAhead}
+	}
+	return nil
+}
+
+// check validates a parsed card date against the policy of the parser.
+func (p *CardDateParser) check(t time.Time) error {
+	if p.Policy == nil {
+		return nil
+	}
+	return p.Policy.Validate(CardDateFrom(t))
+}
+
+// checkNull validates a null CardDate against the policy of p.
+func (p *CardDateParser) checkNull() error {
+	if p.Policy == nil {
+		return nil
+	}
+	return p.Policy.Validate(CardDate{})
+}
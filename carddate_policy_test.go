@@ -0,0 +1,123 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCardDatePolicyValidate(t *testing.T) {
+	now := time.Date(2025, 9, 15, 12, 0, 0, 0, time.UTC)
+	clock := FixedClock(now)
+	month := func(year int, m time.Month) CardDate {
+		return CardDateFrom(time.Date(year, m, 1, 0, 0, 0, 0, time.UTC))
+	}
+
+	tests := []struct {
+		name   string
+		policy CardDatePolicy
+		date   CardDate
+		want   error
+	}{
+		{"zero policy", CardDatePolicy{}, month(2001, 1), nil},
+		{"zero policy null", CardDatePolicy{}, CardDate{}, nil},
+		{"reject null", CardDatePolicy{RejectNull: true}, CardDate{}, ErrCardDateNull},
+		{"first of month", CardDatePolicy{RequireFirstOfMonth: true}, month(2025, 9), nil},
+		{"not first of month", CardDatePolicy{RequireFirstOfMonth: true},
+			CardDate{Time: time.Date(2025, 9, 2, 0, 0, 0, 0, time.UTC), Valid: true}, ErrCardDateNotNormalized},
+		{"expiry month", CardDatePolicy{RejectExpired: true, Clock: clock}, month(2025, 9), nil},
+		{"expired", CardDatePolicy{RejectExpired: true, Clock: clock}, month(2025, 8), ErrCardExpired},
+		{"grace period", CardDatePolicy{RejectExpired: true, GracePeriod: 15 * 24 * time.Hour, Clock: clock},
+			month(2025, 8), nil},
+		{"max years ahead", CardDatePolicy{MaxYearsAhead: 5, Clock: clock}, month(2030, 9), nil},
+		{"too far ahead", CardDatePolicy{MaxYearsAhead: 5, Clock: clock}, month(2030, 10), ErrCardDateTooFarAhead},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate(tt.date)
+			if tt.want == nil {
+				if err != nil {
+					t.Errorf("Validate() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Validate() error = %v, want %v", err, tt.want)
+			}
+			var policyErr *CardDatePolicyError
+			if !errors.As(err, &policyErr) {
+				t.Errorf("Validate() error type = %T, want *CardDatePolicyError", err)
+			}
+		})
+	}
+}
+
+func TestCardDateParserPolicy(t *testing.T) {
+	saved := DefaultCardDateParser
+	defer func() { DefaultCardDateParser = saved }()
+
+	parser := *saved
+	parser.Policy = &CardDatePolicy{
+		RejectExpired: true,
+		Clock:         FixedClock(time.Date(2025, 9, 15, 0, 0, 0, 0, time.UTC)),
+	}
+	DefaultCardDateParser = &parser
+
+	if _, err := CardDateFromString("09/25"); err != nil {
+		t.Errorf("CardDateFromString() error = %v", err)
+	}
+	if _, err := CardDateFromString("08/25"); !errors.Is(err, ErrCardExpired) {
+		t.Errorf("CardDateFromString() error = %v, want %v", err, ErrCardExpired)
+	}
+
+	var cd CardDate
+	if err := json.Unmarshal([]byte(`"08/25"`), &cd); !errors.Is(err, ErrCardExpired) || cd.Valid {
+		t.Errorf("UnmarshalJSON() error = %v, valid = %v", err, cd.Valid)
+	}
+	if err := cd.UnmarshalText([]byte("08/25")); !errors.Is(err, ErrCardExpired) || cd.Valid {
+		t.Errorf("UnmarshalText() error = %v, valid = %v", err, cd.Valid)
+	}
+	if err := cd.Scan(int64(202508)); !errors.Is(err, ErrCardExpired) || cd.Valid {
+		t.Errorf("Scan() error = %v, valid = %v", err, cd.Valid)
+	}
+	if _, err := CardDateFromYYMM("2508"); !errors.Is(err, ErrCardExpired) {
+		t.Errorf("CardDateFromYYMM() error = %v, want %v", err, ErrCardExpired)
+	}
+
+	// ParseExpToTime stays a plain parser.
+	if _, err := ParseExpToTime("08/25"); err != nil {
+		t.Errorf("ParseExpToTime() error = %v", err)
+	}
+}
+
+func TestCardDateParserPolicyRejectNull(t *testing.T) {
+	saved := DefaultCardDateParser
+	defer func() { DefaultCardDateParser = saved }()
+
+	parser := *saved
+	parser.Policy = &CardDatePolicy{RejectNull: true}
+	DefaultCardDateParser = &parser
+
+	cd := CardDateFromMustString("09/25")
+	if err := json.Unmarshal(NullBytes, &cd); !errors.Is(err, ErrCardDateNull) || cd.Valid {
+		t.Errorf("UnmarshalJSON(null) error = %v, valid = %v", err, cd.Valid)
+	}
+	cd = CardDateFromMustString("09/25")
+	if err := cd.UnmarshalText(NullBytes); !errors.Is(err, ErrCardDateNull) || cd.Valid {
+		t.Errorf("UnmarshalText(null) error = %v, valid = %v", err, cd.Valid)
+	}
+	cd = CardDateFromMustString("09/25")
+	if err := cd.Scan(nil); !errors.Is(err, ErrCardDateNull) || cd.Valid {
+		t.Errorf("Scan(nil) error = %v, valid = %v", err, cd.Valid)
+	}
+	var ci CardDateInt
+	if err := ci.Scan(nil); !errors.Is(err, ErrCardDateNull) {
+		t.Errorf("CardDateInt.Scan(nil) error = %v", err)
+	}
+
+	parser.Policy = &CardDatePolicy{}
+	if err := cd.Scan(nil); err != nil || cd.Valid {
+		t.Errorf("Scan(nil) without RejectNull error = %v, valid = %v", err, cd.Valid)
+	}
+}
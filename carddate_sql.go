@@ -0,0 +1,80 @@
+package null
+
+import (
+	"database/sql/driver"
+	"strings"
+)
+
+// CardDateString is a CardDate stored as a MM/YY string, for text columns.
+// It scans every format CardDate does.
+type CardDateString struct {
+	CardDate
+}
+
+// Value implements the driver Valuer interface.
+func (t CardDateString) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return t.Time.Format(cardDateLayout), nil
+}
+
+// CardDateInt is a CardDate stored as YYYYMM in an int64, for integer
+// columns. Six digit strings, as some drivers return integers, are scanned
+// as YYYYMM, anything else like CardDate.
+type CardDateInt struct {
+	CardDate
+}
+
+// Value implements the driver Valuer interface.
+func (t CardDateInt) Value() (driver.Value, error) {
+	if !t.Valid {
+		return nil, nil
+	}
+	return int64(t.Time.Year()*100 + int(t.Time.Month())), nil
+}
+
+// Scan implements the Scanner interface.
+func (t *CardDateInt) Scan(value interface{}) error {
+	var s string
+	switch x := value.(type) {
+	case string:
+		s = x
+	case []byte:
+		s = string(x)
+	}
+	if len(s) != 6 {
+		return t.CardDate.Scan(value)
+	}
+	parsed, err := DefaultCardDateParser.parseFixed("YYYYMM", s)
+	if err != nil {
+		return t.CardDate.Scan(value)
+	}
+	t.Time = normalizeCardDate(parsed)
+	err = DefaultCardDateParser.check(t.Time)
+	t.Valid = err == nil
+	return err
+}
+
+// cardDateStorage is the column type a CardDate is stored as.
+type cardDateStorage int
+
+const (
+	cardDateStorageTime cardDateStorage = iota
+	cardDateStorageString
+	cardDateStorageInt
+)
+
+// cardDateStorageOf returns the storage matching a database column type,
+// e.g. date, timestamp, varchar(5), text or integer.
+func cardDateStorageOf(fieldType string) cardDateStorage {
+	fieldType = strings.ToLower(fieldType)
+	switch {
+	case strings.Contains(fieldType, "char"), strings.Contains(fieldType, "text"):
+		return cardDateStorageString
+	case strings.Contains(fieldType, "int"), strings.Contains(fieldType, "numeric"),
+		strings.Contains(fieldType, "decimal"):
+		return cardDateStorageInt
+	}
+	return cardDateStorageTime
+}
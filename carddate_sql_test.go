@@ -0,0 +1,109 @@
+package null
+
+import (
+	"database/sql/driver"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCardDate_ScanSources(t *testing.T) {
+	date := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		into  sqlCardDate
+		value interface{}
+		err   error
+	}{
+		{name: "Bytes MM/YY", value: []byte("09/25")},
+		{name: "Bytes time", value: []byte("2025-09-01T00:00:00Z")},
+		{name: "Int64 YYYYMM", value: int64(202509)},
+		{name: "Int64 invalid month", value: int64(202513), err: ErrInvalidMonth},
+		{name: "Int64 invalid year", value: int64(209909), err: ErrInvalidYear},
+		{name: "Int64 wrong length", value: int64(2509), err: ErrUnknownFormat},
+		{name: "String MMYYYY", value: "092025"},
+		{name: "Bytes YYYYMM into CardDateInt", into: &CardDateInt{}, value: []byte("202509")},
+		{name: "String YYYYMM into CardDateInt", into: &CardDateInt{}, value: "202509"},
+		{name: "String MMYYYY into CardDateInt", into: &CardDateInt{}, value: "092025"},
+		{name: "Int64 YYYYMM into CardDateInt", into: &CardDateInt{}, value: int64(202509)},
+		{name: "String MM/YY into CardDateString", into: &CardDateString{}, value: "09/25"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if test.into == nil {
+				test.into = &CardDate{}
+			}
+			err := test.into.Scan(test.value)
+			got := cardDateOf(test.into)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("Scan() error = %v, want %v", err, test.err)
+			}
+			if test.err != nil {
+				if got.Valid {
+					t.Error("CardDate should be invalid")
+				}
+				return
+			}
+			assertExprDate(t, CardDateFrom(date), got)
+		})
+	}
+}
+
+// sqlCardDate is a pointer to CardDate or one of its column types.
+type sqlCardDate interface {
+	Scan(value interface{}) error
+	Value() (driver.Value, error)
+}
+
+// cardDateOf returns the CardDate v points to or embeds.
+func cardDateOf(v sqlCardDate) CardDate {
+	switch v := v.(type) {
+	case *CardDateString:
+		return v.CardDate
+	case *CardDateInt:
+		return v.CardDate
+	}
+	return *v.(*CardDate)
+}
+
+func TestCardDate_ScanUnsupported(t *testing.T) {
+	var got CardDate
+	err := got.Scan(3.5)
+	if err == nil || !strings.Contains(err.Error(), "null.CardDate") {
+		t.Errorf("Scan() error should name null.CardDate, instead of %v", err)
+	}
+}
+
+func TestCardDate_ValueStorage(t *testing.T) {
+	date := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name  string
+		value sqlCardDate
+		back  sqlCardDate
+		want  driver.Value
+	}{
+		{name: "Time", value: &CardDate{CardDateFrom(date).Time, true}, back: &CardDate{}, want: date},
+		{name: "String", value: &CardDateString{CardDateFrom(date)}, back: &CardDateString{}, want: "09/25"},
+		{name: "Int", value: &CardDateInt{CardDateFrom(date)}, back: &CardDateInt{}, want: int64(202509)},
+		{name: "Null String", value: &CardDateString{}, back: &CardDateString{}, want: nil},
+		{name: "Null Int", value: &CardDateInt{}, back: &CardDateInt{}, want: nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.value.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("Value() got = %#v, want %#v", got, test.want)
+			}
+
+			if err := test.back.Scan(got); err != nil {
+				t.Fatal(err)
+			}
+			assertExprDate(t, cardDateOf(test.value), cardDateOf(test.back))
+		})
+	}
+}
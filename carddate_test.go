@@ -4,6 +4,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"reflect"
 	"testing"
 	"time"
@@ -117,7 +118,7 @@ func TestCardDateFromString(t *testing.T) {
 			if err != nil {
 				if test.err == nil {
 					t.Fatal(err)
-				} else if test.err != err {
+				} else if !errors.Is(err, test.err) {
 					t.Fatalf("Error should be %s, instead of %s", test.err.Error(), err.Error())
 				}
 				return
@@ -275,7 +276,7 @@ func TestCardDate_UnmarshalJSON(t *testing.T) {
 			if err != nil {
 				if test.err == nil {
 					t.Fatal(err)
-				} else if test.err != err {
+				} else if !errors.Is(err, test.err) {
 					t.Fatalf("Error should be %s, instead of %s", test.err.Error(), err.Error())
 				}
 				return
@@ -422,7 +423,7 @@ func TestJSONUnmarshalForAllFormat(t *testing.T) {
 		t.Run(test.name, func(t *testing.T) {
 			var s testStruct
 			err := json.Unmarshal([]byte(test.exp), &s)
-			if err != test.err {
+			if !errors.Is(err, test.err) {
 				t.Error(err)
 				return
 			}
@@ -532,7 +533,7 @@ func TestCardDate_UnmarshalText(t *testing.T) {
 			if err != nil {
 				if test.err == nil {
 					t.Fatal(err)
-				} else if test.err != err {
+				} else if !errors.Is(err, test.err) {
 					t.Fatalf("Error should be %s, instead of %s", test.err.Error(), err.Error())
 				}
 				return
@@ -551,10 +552,22 @@ func TestCardDate_UnmarshalText(t *testing.T) {
 	}
 }
 
+func TestCardDate_UnmarshalTextErrorResets(t *testing.T) {
+	got := CardDateFromMustString("09/25")
+	for _, text := range []string{"14/2022", "09/99", "lalala"} {
+		if err := got.UnmarshalText([]byte(text)); err == nil {
+			t.Fatalf("UnmarshalText(%q) should fail", text)
+		}
+		if got != (CardDate{}) {
+			t.Errorf("UnmarshalText(%q) left %+v, want the zero CardDate", text, got)
+		}
+	}
+}
+
 func TestSetValid(t *testing.T) {
 	date := time.Date(2020, 9, 0, 0, 0, 0, 0, time.UTC)
 	want := CardDate{
-		Time:  date,
+		Time:  time.Date(2020, 8, 1, 0, 0, 0, 0, time.UTC),
 		Valid: true,
 	}
 	got := CardDate{}
@@ -712,6 +725,49 @@ func TestGenerateCardDate(t *testing.T) {
 	}
 }
 
+func TestCardDateRandomize(t *testing.T) {
+	tests := []struct {
+		fieldType string
+		column    func(CardDate) sqlCardDate
+	}{
+		{"date", func(d CardDate) sqlCardDate { return &d }},
+		{"timestamp with time zone", func(d CardDate) sqlCardDate { return &d }},
+		{"varchar(5)", func(d CardDate) sqlCardDate { return &CardDateString{d} }},
+		{"text", func(d CardDate) sqlCardDate { return &CardDateString{d} }},
+		{"integer", func(d CardDate) sqlCardDate { return &CardDateInt{d} }},
+	}
+	for _, test := range tests {
+		t.Run(test.fieldType, func(t *testing.T) {
+			var n int64
+			next := func() int64 {
+				n += 7
+				return n
+			}
+			for i := 0; i < 100; i++ {
+				var exp CardDate
+				exp.Randomize(next, test.fieldType, false)
+				if !exp.Valid || exp.Time.Day() != 1 {
+					t.Fatalf("Randomize() should give the first day of a month, instead of %v", exp.Time)
+				}
+				v, err := test.column(exp).Value()
+				if err != nil {
+					t.Fatal(err)
+				}
+				scanned := test.column(CardDate{})
+				if err := scanned.Scan(v); err != nil || !cardDateOf(scanned).Time.Equal(exp.Time) {
+					t.Fatalf("Scan(%v) got = %v, %v, want %v", v, cardDateOf(scanned).Time, err, exp.Time)
+				}
+			}
+
+			exp := CardDateFromMustString("09/25")
+			exp.Randomize(next, test.fieldType, true)
+			if exp.Valid {
+				t.Error("Randomize() should give null CardDate")
+			}
+		})
+	}
+}
+
 func TestParseExpToTime(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -972,3 +1028,197 @@ func assertExprDate(t *testing.T, expected CardDate, got CardDate) {
 		t.Errorf("ExprDate valid expexcted %v, instead of %v", expected.Valid, got.Valid)
 	}
 }
+
+func TestCardDate_ExpiresAt(t *testing.T) {
+	warsaw, err := time.LoadLocation("Europe/Warsaw")
+	if err != nil {
+		t.Skip(err)
+	}
+	tests := []struct {
+		name string
+		date CardDate
+		loc  *time.Location
+		want time.Time
+	}{
+		{
+			name: "End of September in UTC",
+			date: CardDateFromMustString("09/25"),
+			loc:  time.UTC,
+			want: time.Date(2025, 9, 30, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "End of February in a leap year",
+			date: CardDateFromMustString("02/24"),
+			loc:  nil,
+			want: time.Date(2024, 2, 29, 23, 59, 59, 999999999, time.UTC),
+		},
+		{
+			name: "End of December in Warsaw",
+			date: CardDateFromMustString("12/25"),
+			loc:  warsaw,
+			want: time.Date(2025, 12, 31, 23, 59, 59, 999999999, warsaw),
+		},
+		{
+			name: "Null",
+			date: CardDate{},
+			loc:  time.UTC,
+			want: time.Time{},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := test.date.ExpiresAt(test.loc)
+			if !got.Equal(test.want) {
+				t.Errorf("ExpiresAt() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCardDate_IsExpired(t *testing.T) {
+	date := CardDateFromMustString("09/25")
+	tests := []struct {
+		name  string
+		date  CardDate
+		now   time.Time
+		grace time.Duration
+		want  bool
+	}{
+		{
+			name: "First day of the expiry month",
+			date: date,
+			now:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			want: false,
+		},
+		{
+			name: "Last instant of the expiry month",
+			date: date,
+			now:  time.Date(2025, 9, 30, 23, 59, 59, 999999999, time.UTC),
+			want: false,
+		},
+		{
+			name: "First instant of the next month",
+			date: date,
+			now:  time.Date(2025, 10, 1, 0, 0, 0, 0, time.UTC),
+			want: true,
+		},
+		{
+			name:  "Inside grace period",
+			date:  date,
+			now:   time.Date(2025, 10, 2, 0, 0, 0, 0, time.UTC),
+			grace: 48 * time.Hour,
+			want:  false,
+		},
+		{
+			name:  "After grace period",
+			date:  date,
+			now:   time.Date(2025, 10, 3, 0, 0, 0, 0, time.UTC),
+			grace: 48 * time.Hour,
+			want:  true,
+		},
+		{
+			name: "Null",
+			date: CardDate{},
+			now:  time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+			want: true,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.date.IsExpiredWithGrace(test.now, test.grace); got != test.want {
+				t.Errorf("IsExpiredWithGrace() got = %v, want %v", got, test.want)
+			}
+			if test.grace == 0 {
+				if got := test.date.IsExpired(test.now); got != test.want {
+					t.Errorf("IsExpired() got = %v, want %v", got, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCardDate_IsExpiredNow(t *testing.T) {
+	old := DefaultClock
+	defer func() { DefaultClock = old }()
+
+	date := CardDateFromMustString("09/25")
+	DefaultClock = FixedClock(time.Date(2025, 9, 30, 12, 0, 0, 0, time.UTC))
+	if date.IsExpiredNow() {
+		t.Error("IsExpiredNow() should be false")
+	}
+	DefaultClock = FixedClock(time.Date(2025, 10, 1, 12, 0, 0, 0, time.UTC))
+	if !date.IsExpiredNow() {
+		t.Error("IsExpiredNow() should be true")
+	}
+}
+
+func TestCardDate_MonthsUntilExpiry(t *testing.T) {
+	date := CardDateFromMustString("09/25")
+	tests := []struct {
+		name string
+		date CardDate
+		now  time.Time
+		want int
+	}{
+		{
+			name: "Expiry month",
+			date: date,
+			now:  time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+		{
+			name: "Previous year",
+			date: date,
+			now:  time.Date(2024, 11, 15, 0, 0, 0, 0, time.UTC),
+			want: 10,
+		},
+		{
+			name: "Expired",
+			date: date,
+			now:  time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			want: -3,
+		},
+		{
+			name: "Null",
+			date: CardDate{},
+			now:  time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			want: 0,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.date.MonthsUntilExpiry(test.now); got != test.want {
+				t.Errorf("MonthsUntilExpiry() got = %d, want %d", got, test.want)
+			}
+		})
+	}
+}
+
+func TestCardDate_UnmarshalJSONString(t *testing.T) {
+	tests := []struct {
+		name   string
+		json   string
+		result string
+		err    bool
+	}{
+		{name: "Escaped slash", json: `"09\/25"`, result: "09/25"},
+		{name: "Unicode escape", json: `"09\u002f25"`, result: "09/25"},
+		{name: "Backslash is not dropped", json: `"09\\25"`, err: true},
+		{name: "Unterminated string", json: `"09/25`, err: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var d CardDate
+			err := d.UnmarshalJSON([]byte(test.json))
+			if (err != nil) != test.err {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, test.err)
+			}
+			if !test.err && d.String() != test.result {
+				t.Errorf("UnmarshalJSON() got = %s, want %s", d.String(), test.result)
+			}
+			if test.err && d.Valid {
+				t.Error("CardDate should be invalid")
+			}
+		})
+	}
+}
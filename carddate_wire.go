@@ -0,0 +1,151 @@
+package null
+
+import (
+	"encoding/hex"
+	"time"
+)
+
+// ParseYYMM parses an ISO 8583 DE14 expiry date in the ASCII YYMM form
+// using DefaultCardDateParser.
+func ParseYYMM(s string) (time.Time, error) {
+	return DefaultCardDateParser.ParseYYMM(s)
+}
+
+// ParseYYMMDD parses an EMV tag 5F24 expiry date in the ASCII YYMMDD form
+// using DefaultCardDateParser.
+func ParseYYMMDD(s string) (time.Time, error) {
+	return DefaultCardDateParser.ParseYYMMDD(s)
+}
+
+// ParseBCDYYMM parses a packed BCD YYMM expiry date (2 bytes)
+// using DefaultCardDateParser.
+func ParseBCDYYMM(b []byte) (time.Time, error) {
+	return DefaultCardDateParser.ParseBCDYYMM(b)
+}
+
+// ParseBCDYYMMDD parses a packed BCD YYMMDD expiry date (3 bytes)
+// using DefaultCardDateParser.
+func ParseBCDYYMMDD(b []byte) (time.Time, error) {
+	return DefaultCardDateParser.ParseBCDYYMMDD(b)
+}
+
+// ParseYYMM parses an ASCII YYMM expiry date into the first day of the month.
+func (p *CardDateParser) ParseYYMM(s string) (time.Time, error) {
+	return p.parseFixed("YYMM", s)
+}
+
+// ParseYYMMDD parses an ASCII YYMMDD expiry date.
+func (p *CardDateParser) ParseYYMMDD(s string) (time.Time, error) {
+	return p.parseFixed("YYMMDD", s)
+}
+
+// ParseBCDYYMM parses a packed BCD YYMM expiry date into the first day of the month.
+func (p *CardDateParser) ParseBCDYYMM(b []byte) (time.Time, error) {
+	t, err := p.parseFixed("YYMM", hex.EncodeToString(b))
+	return t, bcdError("YYMM", b, err)
+}
+
+// ParseBCDYYMMDD parses a packed BCD YYMMDD expiry date.
+func (p *CardDateParser) ParseBCDYYMMDD(b []byte) (time.Time, error) {
+	t, err := p.parseFixed("YYMMDD", hex.EncodeToString(b))
+	return t, bcdError("YYMMDD", b, err)
+}
+
+// FormatYYMM formats t as an ASCII YYMM expiry date.
+func FormatYYMM(t time.Time) string {
+	return t.Format("0601")
+}
+
+// FormatYYMMDD formats t as an ASCII YYMMDD expiry date.
+func FormatYYMMDD(t time.Time) string {
+	return t.Format("060102")
+}
+
+// FormatBCDYYMM formats t as a packed BCD YYMM expiry date.
+func FormatBCDYYMM(t time.Time) []byte {
+	return []byte{toBCD(t.Year() % 100), toBCD(int(t.Month()))}
+}
+
+// FormatBCDYYMMDD formats t as a packed BCD YYMMDD expiry date.
+func FormatBCDYYMMDD(t time.Time) []byte {
+	return []byte{toBCD(t.Year() % 100), toBCD(int(t.Month())), toBCD(t.Day())}
+}
+
+// CardDateFromYYMM creates a CardDate from an ASCII YYMM expiry date.
+func CardDateFromYYMM(s string) (CardDate, error) {
+	return cardDateFromWire(ParseYYMM(s))
+}
+
+// CardDateFromYYMMDD creates a CardDate from an ASCII YYMMDD expiry date.
+// The day is validated and then dropped.
+func CardDateFromYYMMDD(s string) (CardDate, error) {
+	return cardDateFromWire(ParseYYMMDD(s))
+}
+
+// CardDateFromBCDYYMM creates a CardDate from a packed BCD YYMM expiry date.
+func CardDateFromBCDYYMM(b []byte) (CardDate, error) {
+	return cardDateFromWire(ParseBCDYYMM(b))
+}
+
+// CardDateFromBCDYYMMDD creates a CardDate from a packed BCD YYMMDD expiry date.
+// The day is validated and then dropped.
+func CardDateFromBCDYYMMDD(b []byte) (CardDate, error) {
+	return cardDateFromWire(ParseBCDYYMMDD(b))
+}
+
+func cardDateFromWire(t time.Time, err error) (CardDate, error) {
+	if err != nil {
+		return CardDate{}, err
+	}
+	t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	if err := DefaultCardDateParser.check(t); err != nil {
+		return CardDate{}, err
+	}
+	return CardDateFrom(t), nil
+}
+
+// YYMM returns the ASCII YYMM form of the CardDate, or an empty string if null.
+func (t CardDate) YYMM() string {
+	if !t.Valid {
+		return ""
+	}
+	return FormatYYMM(t.Time)
+}
+
+// YYMMDD returns the ASCII YYMMDD form of the CardDate with the last day of the
+// expiry month, as used by EMV, or an empty string if null.
+func (t CardDate) YYMMDD() string {
+	if !t.Valid {
+		return ""
+	}
+	return FormatYYMMDD(t.lastDay())
+}
+
+// BCDYYMM returns the packed BCD YYMM form of the CardDate, or nil if null.
+func (t CardDate) BCDYYMM() []byte {
+	if !t.Valid {
+		return nil
+	}
+	return FormatBCDYYMM(t.Time)
+}
+
+// BCDYYMMDD returns the packed BCD YYMMDD form of the CardDate with the last
+// day of the expiry month, or nil if null.
+func (t CardDate) BCDYYMMDD() []byte {
+	if !t.Valid {
+		return nil
+	}
+	return FormatBCDYYMMDD(t.lastDay())
+}
+
+func (t CardDate) lastDay() time.Time {
+	return time.Date(t.Time.Year(), t.Time.Month(), daysIn(t.Time.Year(), t.Time.Month()), 0, 0, 0, 0, time.UTC)
+}
+
+func daysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func toBCD(n int) byte {
+	return byte(n/10)<<4 | byte(n%10)
+}
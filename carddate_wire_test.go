@@ -0,0 +1,196 @@
+package null
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestParseYYMM(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  string
+		want time.Time
+		err  error
+	}{
+		{
+			name: "Valid",
+			exp:  "2509",
+			want: time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Invalid month",
+			exp:  "2513",
+			err:  ErrInvalidMonth,
+		},
+		{
+			name: "Invalid year",
+			exp:  "5509",
+			err:  ErrInvalidYear,
+		},
+		{
+			name: "Not digits",
+			exp:  "25/9",
+			err:  ErrInvalidMonth,
+		},
+		{
+			name: "Wrong length",
+			exp:  "250901",
+			err:  ErrUnknownFormat,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseYYMM(test.exp)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ParseYYMM() error = %v, want %v", err, test.err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("ParseYYMM() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseYYMMDD(t *testing.T) {
+	tests := []struct {
+		name string
+		exp  string
+		want time.Time
+		err  error
+	}{
+		{
+			name: "Valid",
+			exp:  "250930",
+			want: time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Leap day",
+			exp:  "240229",
+			want: time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name: "Invalid day",
+			exp:  "250931",
+			err:  ErrInvalidDay,
+		},
+		{
+			name: "Zero day",
+			exp:  "250900",
+			err:  ErrInvalidDay,
+		},
+		{
+			name: "Invalid month",
+			exp:  "250031",
+			err:  ErrInvalidMonth,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := ParseYYMMDD(test.exp)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ParseYYMMDD() error = %v, want %v", err, test.err)
+			}
+			if !got.Equal(test.want) {
+				t.Errorf("ParseYYMMDD() got = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestParseBCD(t *testing.T) {
+	got, err := ParseBCDYYMM([]byte{0x25, 0x09})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseBCDYYMM() got = %v, want %v", got, want)
+	}
+
+	got, err = ParseBCDYYMMDD([]byte{0x25, 0x09, 0x30})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("ParseBCDYYMMDD() got = %v, want %v", got, want)
+	}
+
+	if _, err := ParseBCDYYMM([]byte{0x25, 0x0a}); !errors.Is(err, ErrInvalidMonth) {
+		t.Errorf("ParseBCDYYMM() error = %v, want %v", err, ErrInvalidMonth)
+	}
+	if _, err := ParseBCDYYMMDD([]byte{0x25, 0x09}); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("ParseBCDYYMMDD() error = %v, want %v", err, ErrUnknownFormat)
+	}
+}
+
+func TestFormatWire(t *testing.T) {
+	date := time.Date(2025, 9, 30, 0, 0, 0, 0, time.UTC)
+	if got := FormatYYMM(date); got != "2509" {
+		t.Errorf("FormatYYMM() got = %s, want 2509", got)
+	}
+	if got := FormatYYMMDD(date); got != "250930" {
+		t.Errorf("FormatYYMMDD() got = %s, want 250930", got)
+	}
+	if got := FormatBCDYYMM(date); !bytes.Equal(got, []byte{0x25, 0x09}) {
+		t.Errorf("FormatBCDYYMM() got = %x, want 2509", got)
+	}
+	if got := FormatBCDYYMMDD(date); !bytes.Equal(got, []byte{0x25, 0x09, 0x30}) {
+		t.Errorf("FormatBCDYYMMDD() got = %x, want 250930", got)
+	}
+}
+
+func TestCardDateWire(t *testing.T) {
+	want := CardDateFromMustString("02/24")
+	tests := []struct {
+		name string
+		fn   func() (CardDate, error)
+	}{
+		{
+			name: "YYMM",
+			fn:   func() (CardDate, error) { return CardDateFromYYMM("2402") },
+		},
+		{
+			name: "YYMMDD",
+			fn:   func() (CardDate, error) { return CardDateFromYYMMDD("240229") },
+		},
+		{
+			name: "BCD YYMM",
+			fn:   func() (CardDate, error) { return CardDateFromBCDYYMM([]byte{0x24, 0x02}) },
+		},
+		{
+			name: "BCD YYMMDD",
+			fn:   func() (CardDate, error) { return CardDateFromBCDYYMMDD([]byte{0x24, 0x02, 0x29}) },
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := test.fn()
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertExprDate(t, want, got)
+		})
+	}
+
+	if got := want.YYMM(); got != "2402" {
+		t.Errorf("YYMM() got = %s, want 2402", got)
+	}
+	if got := want.YYMMDD(); got != "240229" {
+		t.Errorf("YYMMDD() got = %s, want 240229", got)
+	}
+	if got := want.BCDYYMM(); !bytes.Equal(got, []byte{0x24, 0x02}) {
+		t.Errorf("BCDYYMM() got = %x, want 2402", got)
+	}
+	if got := want.BCDYYMMDD(); !bytes.Equal(got, []byte{0x24, 0x02, 0x29}) {
+		t.Errorf("BCDYYMMDD() got = %x, want 240229", got)
+	}
+
+	null := CardDate{}
+	if null.YYMM() != "" || null.YYMMDD() != "" || null.BCDYYMM() != nil || null.BCDYYMMDD() != nil {
+		t.Error("null CardDate should encode to empty values")
+	}
+	if _, err := CardDateFromYYMMDD("240230"); !errors.Is(err, ErrInvalidDay) {
+		t.Errorf("CardDateFromYYMMDD() error = %v, want %v", err, ErrInvalidDay)
+	}
+}
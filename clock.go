@@ -0,0 +1,30 @@
+package null
+
+import "time"
+
+// Clock provides the current time. It allows to pin the time in tests.
+type Clock interface {
+	Now() time.Time
+}
+
+// ClockFunc is an adapter to use an ordinary function as Clock.
+type ClockFunc func() time.Time
+
+// Now calls f().
+func (f ClockFunc) Now() time.Time {
+	return f()
+}
+
+// FixedClock returns a Clock that always reports t.
+func FixedClock(t time.Time) Clock {
+	return ClockFunc(func() time.Time {
+		return t
+	})
+}
+
+// SystemClock is a Clock backed by time.Now.
+var SystemClock Clock = ClockFunc(time.Now)
+
+// DefaultClock is used wherever the package needs the current time and no
+// Clock was given explicitly.
+var DefaultClock = SystemClock
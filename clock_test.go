@@ -0,0 +1,26 @@
+package null
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFixedClock(t *testing.T) {
+	want := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	clock := FixedClock(want)
+	if got := clock.Now(); !got.Equal(want) {
+		t.Errorf("Now() got = %v, want %v", got, want)
+	}
+}
+
+func TestClockFunc(t *testing.T) {
+	calls := 0
+	clock := ClockFunc(func() time.Time {
+		calls++
+		return time.Time{}
+	})
+	clock.Now()
+	if calls != 1 {
+		t.Errorf("ClockFunc should be called once, instead of %d", calls)
+	}
+}
@@ -0,0 +1,236 @@
+package null
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// Operations of a Change, named as in RFC 6902.
+const (
+	OpAdd     = "add"
+	OpRemove  = "remove"
+	OpReplace = "replace"
+)
+
+// Change is a field that differs between the two values given to Diff.
+type Change struct {
+	// Op is OpAdd for a field that was null or omitted from JSON, OpRemove
+	// for a deleted map entry or a field now omitted from JSON, and
+	// OpReplace for a field that changed value, including to null.
+	Op string
+	// Path is the JSON pointer (RFC 6901) of the field, e.g. /address/city.
+	Path string
+	// Field is the Go path of the field, e.g. Address.City or Tags[b].
+	Field string
+	// Old and New are the field values, nil for a missing map entry.
+	Old, New interface{}
+}
+
+// PatchOperation is an operation of an RFC 6902 JSON patch. Value is
+// encoded for every operation but OpRemove, as null if it is nil.
+type PatchOperation struct {
+	Op    string
+	Path  string
+	Value interface{}
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o PatchOperation) MarshalJSON() ([]byte, error) {
+	if o.Op == OpRemove {
+		return json.Marshal(struct {
+			Op   string `json:"op"`
+			Path string `json:"path"`
+		}{o.Op, o.Path})
+	}
+	return json.Marshal(struct {
+		Op    string      `json:"op"`
+		Path  string      `json:"path"`
+		Value interface{} `json:"value"`
+	}{o.Op, o.Path, o.Value})
+}
+
+// Diff compares from and to, two structs or pointers to structs of the same
+// type, field by field and returns the fields that differ, in field order.
+// Fields are matched by their JSON names, as in ApplyMergePatch, and the
+// omitempty option is honoured. So is omitzero, but only when the running
+// encoding/json supports it, from Go 1.24, so the patch matches what
+// json.Marshal writes. Nested structs and maps with string keys are compared
+// member by member; other fields, including slices and the types of the
+// package, are compared as a whole. Any two null values are equal, whatever
+// the value they hold.
+func Diff(from, to interface{}) ([]Change, error) {
+	fv, tv := reflect.ValueOf(from), reflect.ValueOf(to)
+	if fv.Type() != tv.Type() {
+		return nil, fmt.Errorf("null: cannot diff %T and %T", from, to)
+	}
+	if fv.Kind() == reflect.Ptr {
+		if fv.IsNil() || tv.IsNil() {
+			return nil, fmt.Errorf("null: cannot diff a nil %T", from)
+		}
+		fv, tv = fv.Elem(), tv.Elem()
+	}
+	if fv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: cannot diff %T, not a struct", from)
+	}
+	var d differ
+	d.diffStruct(fv, tv, "", "")
+	return d.changes, nil
+}
+
+// JSONPatch converts changes into an RFC 6902 JSON patch, which turns the
+// JSON encoding of the first value given to Diff into that of the second.
+// Values are encoded with their own marshalers, so a Secret or a PAN stays
+// redacted.
+func JSONPatch(changes []Change) []PatchOperation {
+	ops := make([]PatchOperation, len(changes))
+	for i, c := range changes {
+		ops[i] = PatchOperation{Op: c.Op, Path: c.Path}
+		if c.Op != OpRemove {
+			ops[i].Value = c.New
+		}
+	}
+	return ops
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (d *differ) diffStruct(a, b reflect.Value, path, field string) {
+	t := a.Type()
+	for _, f := range jsonFields(t) {
+		name := t.FieldByIndex(f.index).Name
+		if field != "" {
+			name = field + "." + name
+		}
+		av, bv := a.FieldByIndex(f.index), b.FieldByIndex(f.index)
+		fieldPath := path + "/" + escapeJSONPointer(f.name)
+		switch aOmitted, bOmitted := f.omitted(av), f.omitted(bv); {
+		case aOmitted && bOmitted:
+		case aOmitted:
+			d.add(OpAdd, fieldPath, name, av.Interface(), bv.Interface())
+		case bOmitted:
+			d.add(OpRemove, fieldPath, name, av.Interface(), bv.Interface())
+		default:
+			d.diffValue(av, bv, fieldPath, name)
+		}
+	}
+}
+
+func (d *differ) diffValue(a, b reflect.Value, path, field string) {
+	aNull, bNull := isNullValue(a), isNullValue(b)
+	switch {
+	case aNull && bNull:
+		return
+	case aNull:
+		d.add(OpAdd, path, field, a.Interface(), b.Interface())
+		return
+	case bNull:
+		d.add(OpReplace, path, field, a.Interface(), b.Interface())
+		return
+	}
+
+	t := a.Type()
+	switch {
+	case t.Kind() == reflect.Struct && isPlainStruct(t):
+		d.diffStruct(a, b, path, field)
+	case t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct && isPlainStruct(t.Elem()):
+		d.diffStruct(a.Elem(), b.Elem(), path, field)
+	case t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+		d.diffMap(a, b, path, field)
+	default:
+		if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+			d.add(OpReplace, path, field, a.Interface(), b.Interface())
+		}
+	}
+}
+
+func (d *differ) diffMap(a, b reflect.Value, path, field string) {
+	keys := make([]string, 0, a.Len()+b.Len())
+	for _, m := range []reflect.Value{a, b} {
+		for it := m.MapRange(); it.Next(); {
+			keys = append(keys, it.Key().String())
+		}
+	}
+	sort.Strings(keys)
+	for i, k := range keys {
+		if i > 0 && keys[i-1] == k {
+			continue
+		}
+		key := reflect.ValueOf(k).Convert(a.Type().Key())
+		elemPath, elemField := path+"/"+escapeJSONPointer(k), field+"["+k+"]"
+		av, bv := a.MapIndex(key), b.MapIndex(key)
+		switch {
+		case !av.IsValid():
+			d.add(OpAdd, elemPath, elemField, nil, bv.Interface())
+		case !bv.IsValid():
+			d.add(OpRemove, elemPath, elemField, av.Interface(), nil)
+		default:
+			d.diffValue(av, bv, elemPath, elemField)
+		}
+	}
+}
+
+func (d *differ) add(op, path, field string, from, to interface{}) {
+	d.changes = append(d.changes, Change{Op: op, Path: path, Field: field, Old: from, New: to})
+}
+
+var jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// jsonOmitsZero reports whether encoding/json knows the omitzero option,
+// which Go 1.18 to 1.23 ignore.
+var jsonOmitsZero = func() bool {
+	data, err := json.Marshal(struct {
+		A int `json:",omitzero"`
+	}{})
+	return err == nil && string(data) == "{}"
+}()
+
+// isPlainStruct reports whether the struct type t is encoded field by field,
+// rather than by a marshaler as the types of the package are.
+func isPlainStruct(t reflect.Type) bool {
+	return !t.Implements(jsonMarshalerType) && !reflect.PtrTo(t).Implements(jsonMarshalerType)
+}
+
+// omitted reports whether encoding/json leaves v, a value of f, out.
+func (f jsonField) omitted(v reflect.Value) bool {
+	if f.omitZero && jsonOmitsZero {
+		if v.Kind() == reflect.Ptr && v.IsNil() {
+			return true
+		}
+		if z, ok := v.Interface().(interface{ IsZero() bool }); ok {
+			if z.IsZero() {
+				return true
+			}
+		} else if v.IsZero() {
+			return true
+		}
+	}
+	if !f.omitEmpty {
+		return false
+	}
+	switch v.Kind() {
+	case reflect.Array, reflect.Map, reflect.Slice, reflect.String:
+		return v.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		return false
+	}
+	return v.IsZero()
+}
+
+// isNullValue reports whether v is a nil pointer, map, slice or interface,
+// or a struct with a false Valid field such as the types of the package.
+func isNullValue(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface:
+		return v.IsNil()
+	case reflect.Struct:
+		valid := v.FieldByName("Valid")
+		return valid.IsValid() && valid.Kind() == reflect.Bool && !valid.Bool()
+	}
+	return false
+}
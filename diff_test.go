@@ -0,0 +1,204 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := newMergeCustomer()
+	b := newMergeCustomer()
+	b.Name = StringFrom("Ada L.")
+	b.Age = Int64{}
+	b.Note = StringFrom("gold")
+	b.Address.City = StringFrom("Paris")
+	b.Billing = &mergeAddress{City: StringFrom("Lyon")}
+	b.Tags = map[string]string{"b": "two", "c/d": "3"}
+	b.Score = Float64From(1.5)
+	b.Ignored = StringFrom("changed")
+
+	changes, err := Diff(a, &b)
+	if err == nil {
+		t.Fatal("expected error for different types")
+	}
+	changes, err = Diff(&a, &b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []Change{
+		{OpReplace, "/name", "Name", StringFrom("Ada"), StringFrom("Ada L.")},
+		{OpReplace, "/age", "Age", Int64From(36), Int64{}},
+		{OpReplace, "/address/city", "Address.City", StringFrom("London"), StringFrom("Paris")},
+		{OpAdd, "/billing", "Billing", (*mergeAddress)(nil), b.Billing},
+		{OpRemove, "/tags/a", "Tags[a]", "1", nil},
+		{OpReplace, "/tags/b", "Tags[b]", "2", "two"},
+		{OpAdd, "/tags/c~1d", "Tags[c/d]", nil, "3"},
+		{OpAdd, "/Score", "Score", Float64{}, Float64From(1.5)},
+		{OpReplace, "/note", "Note", StringFrom("vip"), StringFrom("gold")},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got  %+v\nwant %+v", changes, want)
+	}
+
+	data, err := json.Marshal(JSONPatch(changes))
+	maybePanic(err)
+	assertJSONEquals(t, data, `[`+
+		`{"op":"replace","path":"/name","value":"Ada L."},`+
+		`{"op":"replace","path":"/age","value":null},`+
+		`{"op":"replace","path":"/address/city","value":"Paris"},`+
+		`{"op":"add","path":"/billing","value":{"street":null,"city":"Lyon"}},`+
+		`{"op":"remove","path":"/tags/a"},`+
+		`{"op":"replace","path":"/tags/b","value":"two"},`+
+		`{"op":"add","path":"/tags/c~1d","value":"3"},`+
+		`{"op":"add","path":"/Score","value":1.5},`+
+		`{"op":"replace","path":"/note","value":"gold"}]`, "JSONPatch")
+}
+
+func TestDiffNulls(t *testing.T) {
+	type row struct {
+		Doc    JSON
+		Secret Secret
+		Nested *mergeAddress
+		List   []int
+	}
+	a := row{Doc: JSON{JSON: NullBytes}, Nested: &mergeAddress{}, List: []int{1}}
+	b := row{Nested: &mergeAddress{City: StringFrom("Oslo")}, List: []int{1}, Secret: SecretFrom("x")}
+
+	changes, err := Diff(a, b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Change{
+		{OpAdd, "/Secret", "Secret", Secret{}, SecretFrom("x")},
+		{OpAdd, "/Nested/city", "Nested.City", String{}, StringFrom("Oslo")},
+	}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got  %+v\nwant %+v", changes, want)
+	}
+
+	if changes, err := Diff(a, a); err != nil || len(changes) != 0 {
+		t.Errorf("Diff(a, a) = %v, %v", changes, err)
+	}
+	if _, err := Diff(1, 2); err == nil {
+		t.Error("expected error for non-struct")
+	}
+}
+
+func TestDiffJSONPatchRoundTrip(t *testing.T) {
+	type omitting struct {
+		Name    String            `json:"name"`
+		Billing *mergeAddress     `json:"billing,omitempty"`
+		Tags    []string          `json:"tags,omitempty"`
+		Labels  map[string]String `json:"labels"`
+		Extra   interface{}       `json:"extra"`
+		Count   int               `json:"count,omitempty"`
+		Since   Time              `json:"since,omitzero"`
+	}
+	full := omitting{
+		Name:    StringFrom("a"),
+		Billing: &mergeAddress{City: StringFrom("Oslo")},
+		Tags:    []string{"x"},
+		Labels:  map[string]String{"k": StringFrom("v"), "n": {}},
+		Extra:   "x",
+		Count:   2,
+		Since:   TimeFrom(timeValue),
+	}
+	changed := omitting{
+		Billing: &mergeAddress{Street: StringFrom("1 Main St")},
+		Labels:  map[string]String{"n": StringFrom("v"), "new": {}},
+		Count:   3,
+	}
+	for _, pair := range [][2]omitting{{full, changed}, {changed, full}, {full, {}}, {{}, full}} {
+		from, to := pair[0], pair[1]
+		changes, err := Diff(from, to)
+		maybePanic(err)
+		patch, err := json.Marshal(JSONPatch(changes))
+		maybePanic(err)
+
+		var doc, ops interface{}
+		data, err := json.Marshal(from)
+		maybePanic(err)
+		maybePanic(json.Unmarshal(data, &doc))
+		maybePanic(json.Unmarshal(patch, &ops))
+		for _, op := range ops.([]interface{}) {
+			if doc, err = applyPatchOperation(doc, op.(map[string]interface{})); err != nil {
+				t.Fatalf("applying %s to %s: %v", patch, data, err)
+			}
+		}
+
+		got, err := json.Marshal(doc)
+		maybePanic(err)
+		want, err := json.Marshal(to)
+		maybePanic(err)
+		if !jsonEqual(got, want) {
+			t.Errorf("patch %s of %s\ngot  %s\nwant %s", patch, data, got, want)
+		}
+	}
+}
+
+func TestDiffOmitZeroUnsupported(t *testing.T) {
+	type row struct {
+		Since Time `json:"since,omitzero"`
+	}
+	from := row{Since: TimeFrom(timeValue)}
+
+	defer func(supported bool) { jsonOmitsZero = supported }(jsonOmitsZero)
+	jsonOmitsZero = false
+	changes, err := Diff(from, row{})
+	maybePanic(err)
+	want := []Change{{OpReplace, "/since", "Since", TimeFrom(timeValue), Time{}}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got  %+v\nwant %+v", changes, want)
+	}
+
+	jsonOmitsZero = true
+	changes, err = Diff(from, row{})
+	maybePanic(err)
+	want = []Change{{OpRemove, "/since", "Since", TimeFrom(timeValue), Time{}}}
+	if !reflect.DeepEqual(changes, want) {
+		t.Errorf("got  %+v\nwant %+v", changes, want)
+	}
+}
+
+// applyPatchOperation applies an add, remove or replace operation of an
+// RFC 6902 patch to a document of nested objects.
+func applyPatchOperation(doc interface{}, op map[string]interface{}) (interface{}, error) {
+	tokens := strings.Split(op["path"].(string), "/")[1:]
+	parent := doc
+	for _, token := range tokens[:len(tokens)-1] {
+		parent = parent.(map[string]interface{})[unescapeJSONPointer(token)]
+	}
+	obj, ok := parent.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("parent is not an object")
+	}
+	key := unescapeJSONPointer(tokens[len(tokens)-1])
+	_, exists := obj[key]
+	switch op["op"] {
+	case OpAdd:
+		obj[key] = op["value"]
+	case OpReplace, OpRemove:
+		if !exists {
+			return nil, fmt.Errorf("%s of missing member %s", op["op"], key)
+		}
+		if op["op"] == OpRemove {
+			delete(obj, key)
+		} else {
+			value, ok := op["value"]
+			if !ok {
+				return nil, errors.New("replace without a value")
+			}
+			obj[key] = value
+		}
+	}
+	return doc, nil
+}
+
+func unescapeJSONPointer(s string) string {
+	return strings.NewReplacer("~1", "/", "~0", "~").Replace(s)
+}
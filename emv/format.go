@@ -0,0 +1,146 @@
+package emv
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// ErrUnknownFormat is returned for a tag whose format is neither given by
+// the struct tag nor found in Formats.
+var ErrUnknownFormat = errors.New("unknown format of the tag")
+
+// Kind is an EMV data format.
+type Kind int
+
+// Kinds of data.
+const (
+	// KindB is binary.
+	KindB Kind = iota
+	// KindN is numeric: BCD digits, left padded with zeros.
+	KindN
+	// KindCN is compressed numeric: BCD digits, right padded with F.
+	KindCN
+	// KindAN is alphanumeric: letters and digits.
+	KindAN
+	// KindANS is alphanumeric and special: printable ASCII characters.
+	KindANS
+)
+
+var kindNames = [...]string{KindB: "b", KindN: "n", KindCN: "cn", KindAN: "an", KindANS: "ans"}
+
+// Format is the data format of a tag, e.g. n12.
+type Format struct {
+	Kind Kind
+	// Length is the number of digits of KindN, the maximum number of digits
+	// of KindCN, of characters of KindAN and KindANS and of bytes of KindB.
+	Length int
+}
+
+// ParseFormat parses a format written as in the EMV specifications,
+// e.g. n12, cn19, an16, ans26 or b8.
+func ParseFormat(s string) (Format, error) {
+	i := strings.IndexAny(s, "0123456789")
+	if i > 0 {
+		for k, name := range kindNames {
+			if s[:i] != name {
+				continue
+			}
+			n, err := strconv.Atoi(s[i:])
+			if err != nil || n <= 0 {
+				break
+			}
+			return Format{Kind: Kind(k), Length: n}, nil
+		}
+	}
+	return Format{}, errors.New("emv: invalid format " + strconv.Quote(s))
+}
+
+// String returns the format as written in the EMV specifications.
+func (f Format) String() string {
+	return kindNames[f.Kind] + strconv.Itoa(f.Length)
+}
+
+// Formats are the formats of well known EMV tags, used when a struct tag
+// does not give one. Add the proprietary tags of a scheme to it at init.
+var Formats = map[Tag]Format{
+	0x4F:   {KindB, 16},   // Application Identifier (AID)
+	0x50:   {KindANS, 16}, // Application Label
+	0x57:   {KindB, 19},   // Track 2 Equivalent Data
+	0x5A:   {KindCN, 19},  // Application PAN
+	0x5F20: {KindANS, 26}, // Cardholder Name
+	0x5F24: {KindN, 6},    // Application Expiration Date
+	0x5F25: {KindN, 6},    // Application Effective Date
+	0x5F28: {KindN, 3},    // Issuer Country Code
+	0x5F2A: {KindN, 3},    // Transaction Currency Code
+	0x5F34: {KindN, 2},    // Application PAN Sequence Number
+	0x82:   {KindB, 2},    // Application Interchange Profile
+	0x84:   {KindB, 16},   // Dedicated File Name
+	0x95:   {KindB, 5},    // Terminal Verification Results
+	0x9A:   {KindN, 6},    // Transaction Date
+	0x9C:   {KindN, 2},    // Transaction Type
+	0x9F02: {KindN, 12},   // Amount, Authorised
+	0x9F03: {KindN, 12},   // Amount, Other
+	0x9F10: {KindB, 32},   // Issuer Application Data
+	0x9F1A: {KindN, 3},    // Terminal Country Code
+	0x9F26: {KindB, 8},    // Application Cryptogram
+	0x9F27: {KindB, 1},    // Cryptogram Information Data
+	0x9F33: {KindB, 3},    // Terminal Capabilities
+	0x9F34: {KindB, 3},    // CVM Results
+	0x9F36: {KindB, 2},    // Application Transaction Counter
+	0x9F37: {KindB, 4},    // Unpredictable Number
+}
+
+// digits returns the digits of a KindN or KindCN value without padding.
+func (f Format) digits(value []byte) (string, error) {
+	s := strings.ToUpper(hex.EncodeToString(value))
+	if f.Kind == KindCN {
+		s = strings.TrimRight(s, "F")
+	} else if extra := len(s) - f.Length; extra > 0 && strings.Trim(s[:extra], "0") == "" {
+		s = s[extra:]
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return "", ErrInvalidValue
+		}
+	}
+	return s, nil
+}
+
+// packDigits encodes the digits s as a KindN or KindCN value.
+func (f Format) packDigits(s string) ([]byte, error) {
+	if len(s) > f.Length {
+		return nil, ErrInvalidValue
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || s[i] > '9' {
+			return nil, ErrInvalidValue
+		}
+	}
+	if f.Kind == KindCN {
+		if len(s)%2 != 0 {
+			s += "F"
+		}
+	} else {
+		s = strings.Repeat("0", (f.Length+1)/2*2-len(s)) + s
+	}
+	return hex.DecodeString(s)
+}
+
+// text returns a KindAN or KindANS value as a string.
+func (f Format) text(value []byte) (string, error) {
+	for _, c := range value {
+		ok := c >= ' ' && c <= '~'
+		if f.Kind == KindAN {
+			ok = c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+		}
+		if !ok {
+			return "", ErrInvalidValue
+		}
+	}
+	if len(value) > f.Length {
+		return "", ErrInvalidValue
+	}
+	return string(value), nil
+}
@@ -0,0 +1,27 @@
+package emv
+
+import "testing"
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Format
+	}{
+		{"n12", Format{KindN, 12}},
+		{"cn19", Format{KindCN, 19}},
+		{"an8", Format{KindAN, 8}},
+		{"ans26", Format{KindANS, 26}},
+		{"b4", Format{KindB, 4}},
+	}
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.s)
+		if err != nil || got != tt.want || got.String() != tt.s {
+			t.Errorf("ParseFormat(%q) got = %v, %v, want %v", tt.s, got, err, tt.want)
+		}
+	}
+	for _, s := range []string{"", "n", "12", "x12", "n0", "n1x"} {
+		if _, err := ParseFormat(s); err == nil {
+			t.Errorf("ParseFormat(%q) should fail", s)
+		}
+	}
+}
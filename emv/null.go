@@ -0,0 +1,328 @@
+package emv
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/metricsglobal/null"
+)
+
+// Marshal encodes the struct v points to. Its fields tagged emv:"5F24" or
+// emv:"9F02,n12" hold the value of that tag and are one of null.String,
+// null.Secret, null.Int64, null.Int, null.Bytes, null.CardDate and null.PAN;
+// a null value leaves the tag out. The format after the comma overrides the
+// one found in Formats. A struct field tagged with a constructed tag, e.g.
+// emv:"70", is encoded as a template of its own tagged fields.
+// A null.CardDate is written as YYMM into n4 and as YYMMDD into n6, with the
+// first day of the month for the Application Effective Date 5F25 and the
+// last day for any other tag, such as the Application Expiration Date 5F24.
+func Marshal(v interface{}) ([]byte, error) {
+	tlvs, err := Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return Pack(tlvs)
+}
+
+// Unmarshal decodes data into the struct v points to, see Marshal.
+// Tags absent from data are stored as null values.
+func Unmarshal(data []byte, v interface{}) error {
+	tlvs, err := Unpack(data)
+	if err != nil {
+		return err
+	}
+	return Decode(tlvs, v)
+}
+
+// Encode converts the struct v points to into data objects, see Marshal.
+func Encode(v interface{}) ([]TLV, error) {
+	var tlvs []TLV
+	err := walkTagged(v, func(tag Tag, format Format, field reflect.Value) error {
+		if field.Kind() == reflect.Struct && !isNullType(field) {
+			children, err := Encode(field.Addr().Interface())
+			if err == nil && len(children) > 0 {
+				tlvs = append(tlvs, TLV{Tag: tag, Children: children})
+			}
+			return err
+		}
+		value, valid, err := encodeValue(tag, format, field.Addr().Interface())
+		if valid && err == nil {
+			tlvs = append(tlvs, TLV{Tag: tag, Value: value})
+		}
+		return err
+	})
+	return tlvs, err
+}
+
+// Decode stores the values of tlvs into the struct v points to, see Marshal.
+// The tags are searched depth first, so a flat struct can be decoded from
+// data objects nested in templates.
+func Decode(tlvs []TLV, v interface{}) error {
+	return walkTagged(v, func(tag Tag, format Format, field reflect.Value) error {
+		tlv, found := Find(tlvs, tag)
+		if field.Kind() == reflect.Struct && !isNullType(field) {
+			return Decode(tlv.Children, field.Addr().Interface())
+		}
+		p := field.Addr().Interface()
+		if !found {
+			// Report an unsupported type even if the tag is absent.
+			if _, _, err := encodeValue(tag, format, p); err != nil {
+				return err
+			}
+			field.Set(reflect.Zero(field.Type()))
+			return nil
+		}
+		return decodeValue(format, tlv.Value, p)
+	})
+}
+
+// walkTagged calls fn with the tag, the format and the value of every tagged
+// field of the struct v points to.
+func walkTagged(v interface{}, fn func(tag Tag, format Format, field reflect.Value) error) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("emv: expected a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		sf := rv.Type().Field(i)
+		opt, ok := sf.Tag.Lookup("emv")
+		if !ok || opt == "-" {
+			continue
+		}
+		name, formatName := opt, ""
+		if j := strings.IndexByte(opt, ','); j >= 0 {
+			name, formatName = opt[:j], opt[j+1:]
+		}
+		tag, err := ParseTag(name)
+		if err != nil {
+			return errors.New("emv: invalid tag " + strconv.Quote(opt) + " of " + sf.Name)
+		}
+		field := rv.Field(i)
+		var format Format
+		switch {
+		case field.Kind() == reflect.Struct && !isNullType(field):
+			if !tag.Constructed() {
+				return &TagError{Tag: tag, Offset: -1, Err: ErrUnsupportedType}
+			}
+		case formatName != "":
+			if format, err = ParseFormat(formatName); err != nil {
+				return err
+			}
+		default:
+			if format, ok = Formats[tag]; !ok {
+				return &TagError{Tag: tag, Offset: -1, Err: ErrUnknownFormat}
+			}
+		}
+		if err := fn(tag, format, field); err != nil {
+			if _, ok := err.(*TagError); ok {
+				return err
+			}
+			return &TagError{Tag: tag, Offset: -1, Err: err}
+		}
+	}
+	return nil
+}
+
+// isNullType reports whether v is one of the types of package null.
+func isNullType(v reflect.Value) bool {
+	return v.Type().PkgPath() == reflect.TypeOf(null.String{}).PkgPath()
+}
+
+// effectiveDateTags are the tags whose n6 dates start a period, written with
+// the first day of the month rather than the last.
+var effectiveDateTags = map[Tag]bool{
+	0x5F25: true, // Application Effective Date
+}
+
+// encodeValue returns the value of tag t of format f held by p, and whether
+// it is not null.
+func encodeValue(t Tag, f Format, p interface{}) ([]byte, bool, error) {
+	var (
+		value []byte
+		valid bool
+		err   error
+	)
+	switch x := p.(type) {
+	case *null.String:
+		value, err = f.packString(x.String)
+		valid = x.Valid
+	case *null.Secret:
+		value, err = f.packString(x.Reveal())
+		valid = x.Valid
+	case *null.Bytes:
+		value, valid = x.Bytes, x.Valid
+		if len(value) > f.Length && f.Kind == KindB {
+			err = ErrInvalidValue
+		}
+	case *null.Int64:
+		value, err = f.packInt(x.Int64)
+		valid = x.Valid
+	case *null.Int:
+		value, err = f.packInt(int64(x.Int))
+		valid = x.Valid
+	case *null.PAN:
+		if err = f.numeric(); err == nil {
+			value, err = f.packDigits(x.Reveal())
+		}
+		valid = x.Valid
+	case *null.CardDate:
+		switch {
+		case f.Kind == KindN && f.Length == 6 && effectiveDateTags[t]:
+			if x.Valid {
+				value = null.FormatBCDYYMMDD(x.FirstDay())
+			}
+		case f.Kind == KindN && f.Length == 6:
+			value = x.BCDYYMMDD()
+		case f.Kind == KindN && f.Length == 4:
+			value = x.BCDYYMM()
+		default:
+			err = ErrUnsupportedType
+		}
+		valid = x.Valid
+	default:
+		err = ErrUnsupportedType
+	}
+	if !valid && err != ErrUnsupportedType {
+		err = nil
+	}
+	return value, valid, err
+}
+
+// decodeValue stores value, the value of a tag of format f, in p.
+func decodeValue(f Format, value []byte, p interface{}) error {
+	var err error
+	switch x := p.(type) {
+	case *null.String:
+		var s string
+		if s, err = f.unpackString(value); err == nil {
+			*x = null.StringFrom(s)
+		}
+	case *null.Secret:
+		var s string
+		if s, err = f.unpackString(value); err == nil {
+			*x = null.SecretFrom(s)
+		}
+	case *null.Bytes:
+		*x = null.BytesFrom(value)
+	case *null.Int64:
+		var n int64
+		if n, err = f.unpackInt(value); err == nil {
+			*x = null.Int64From(n)
+		}
+	case *null.Int:
+		var n int64
+		if n, err = f.unpackInt(value); err == nil {
+			*x = null.IntFrom(int(n))
+		}
+	case *null.PAN:
+		if err = f.numeric(); err == nil {
+			var s string
+			if s, err = f.digits(value); err == nil {
+				*x, err = null.PANFromString(s)
+			}
+		}
+	case *null.CardDate:
+		switch {
+		case f.Kind == KindN && len(value) == 3:
+			*x, err = null.CardDateFromBCDYYMMDD(value)
+		case f.Kind == KindN && len(value) == 2:
+			*x, err = null.CardDateFromBCDYYMM(value)
+		case f.Kind == KindN:
+			err = ErrInvalidValue
+		default:
+			err = ErrUnsupportedType
+		}
+	default:
+		err = ErrUnsupportedType
+	}
+	return err
+}
+
+func (f Format) numeric() error {
+	if f.Kind != KindN && f.Kind != KindCN {
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// packString encodes the digits of KindN and KindCN, the text of KindAN and
+// KindANS and the hexadecimal form of KindB.
+func (f Format) packString(s string) ([]byte, error) {
+	switch f.Kind {
+	case KindN, KindCN:
+		return f.packDigits(s)
+	case KindB:
+		b, err := hex.DecodeString(s)
+		if err != nil || len(b) > f.Length {
+			return nil, ErrInvalidValue
+		}
+		return b, nil
+	}
+	if _, err := f.text([]byte(s)); err != nil {
+		return nil, err
+	}
+	return []byte(s), nil
+}
+
+// unpackString is the inverse of packString.
+func (f Format) unpackString(value []byte) (string, error) {
+	switch f.Kind {
+	case KindN, KindCN:
+		return f.digits(value)
+	case KindB:
+		return strings.ToUpper(hex.EncodeToString(value)), nil
+	}
+	return f.text(value)
+}
+
+// packInt encodes n as the digits of KindN, or in big endian order into
+// the Length bytes of KindB.
+func (f Format) packInt(n int64) ([]byte, error) {
+	switch f.Kind {
+	case KindN:
+		if n < 0 {
+			return nil, ErrInvalidValue
+		}
+		return f.packDigits(strconv.FormatInt(n, 10))
+	case KindB:
+		if n < 0 || f.Length < 8 && n>>(8*uint(f.Length)) != 0 {
+			return nil, ErrInvalidValue
+		}
+		b := make([]byte, f.Length)
+		for i := len(b) - 1; i >= 0 && n != 0; i-- {
+			b[i], n = byte(n), n>>8
+		}
+		return b, nil
+	}
+	return nil, ErrUnsupportedType
+}
+
+// unpackInt is the inverse of packInt.
+func (f Format) unpackInt(value []byte) (int64, error) {
+	switch f.Kind {
+	case KindN:
+		s, err := f.digits(value)
+		if err != nil {
+			return 0, err
+		}
+		if s == "" {
+			return 0, ErrInvalidValue
+		}
+		return strconv.ParseInt(s, 10, 64)
+	case KindB:
+		if len(value) > 8 || len(value) == 8 && value[0]&0x80 != 0 {
+			return 0, ErrInvalidValue
+		}
+		var n int64
+		for _, c := range value {
+			n = n<<8 | int64(c)
+		}
+		return n, nil
+	}
+	return 0, ErrUnsupportedType
+}
@@ -0,0 +1,220 @@
+package emv
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/metricsglobal/null"
+)
+
+type chipData struct {
+	PAN         null.PAN      `emv:"5A"`
+	Expiry      null.CardDate `emv:"5F24"`
+	Sequence    null.Int64    `emv:"5F34"`
+	Amount      null.Int64    `emv:"9F02"`
+	Country     null.String   `emv:"9F1A"`
+	ATC         null.Int      `emv:"9F36"`
+	Name        null.String   `emv:"5F20"`
+	Cryptogram  null.Bytes    `emv:"9F26"`
+	Proprietary null.String   `emv:"DF01,an8"`
+	Ignored     string        `emv:"-"`
+}
+
+func TestUnmarshal(t *testing.T) {
+	v := chipData{Name: null.StringFrom("stale")}
+	if err := Unmarshal(testData, &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.PAN != null.PANFromMustString("4111111111111111") {
+		t.Errorf("PAN got = %v", v.PAN)
+	}
+	if v.Expiry != null.CardDateFromMustString("09/27") {
+		t.Errorf("Expiry got = %v", v.Expiry)
+	}
+	if v.Sequence != null.Int64From(1) || v.Amount != null.Int64From(1000) {
+		t.Errorf("Sequence, Amount got = %v, %v", v.Sequence, v.Amount)
+	}
+	if v.Country != null.StringFrom("840") {
+		t.Errorf("Country got = %v", v.Country)
+	}
+	if v.ATC.Valid || v.Name.Valid || v.Cryptogram.Valid || v.Proprietary.Valid {
+		t.Errorf("absent tags should be null, got %+v", v)
+	}
+}
+
+func TestMarshal(t *testing.T) {
+	v := chipData{
+		PAN:         null.PANFromMustString("4111111111111111"),
+		Expiry:      null.CardDateFromMustString("09/27"),
+		Amount:      null.Int64From(1000),
+		ATC:         null.IntFrom(258),
+		Name:        null.StringFrom("DOE/JOHN"),
+		Proprietary: null.StringFrom("AB12"),
+	}
+	data, err := Marshal(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x5A, 0x08, 0x41, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+		0x5F, 0x24, 0x03, 0x27, 0x09, 0x30,
+		0x9F, 0x02, 0x06, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+		0x9F, 0x36, 0x02, 0x01, 0x02,
+		0x5F, 0x20, 0x08, 'D', 'O', 'E', '/', 'J', 'O', 'H', 'N',
+		0xDF, 0x01, 0x04, 'A', 'B', '1', '2',
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("Marshal() got = % x, want % x", data, want)
+	}
+
+	var got chipData
+	if err := Unmarshal(data, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.PAN != v.PAN || got.Expiry != v.Expiry || got.Amount != v.Amount || got.ATC != v.ATC ||
+		got.Name != v.Name || got.Proprietary != v.Proprietary {
+		t.Errorf("Unmarshal() got = %+v, want %+v", got, v)
+	}
+}
+
+func TestMarshalEffectiveDate(t *testing.T) {
+	type validity struct {
+		Effective null.CardDate `emv:"5F25"`
+		Expiry    null.CardDate `emv:"5F24"`
+	}
+	v := validity{
+		Effective: null.CardDateFromMustString("02/24"),
+		Expiry:    null.CardDateFromMustString("02/27"),
+	}
+	data, err := Marshal(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{
+		0x5F, 0x25, 0x03, 0x24, 0x02, 0x01,
+		0x5F, 0x24, 0x03, 0x27, 0x02, 0x28,
+	}
+	if !bytes.Equal(data, want) {
+		t.Errorf("Marshal() got = % x, want % x", data, want)
+	}
+
+	var got validity
+	if err := Unmarshal(data, &got); err != nil || got != v {
+		t.Errorf("Unmarshal() got = %+v, %v", got, err)
+	}
+}
+
+func TestMarshalTemplate(t *testing.T) {
+	type record struct {
+		PAN    null.PAN      `emv:"5A"`
+		Expiry null.CardDate `emv:"5F24"`
+	}
+	type response struct {
+		Record record     `emv:"70"`
+		Amount null.Int64 `emv:"9F02"`
+	}
+	v := response{
+		Record: record{PAN: null.PANFromMustString("4111111111111111"), Expiry: null.CardDateFromMustString("09/27")},
+	}
+	data, err := Marshal(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if data[0] != 0x70 || int(data[1]) != len(data)-2 {
+		t.Errorf("Marshal() should write a template, got % x", data)
+	}
+
+	var got response
+	if err := Unmarshal(data, &got); err != nil || got.Record != v.Record || got.Amount.Valid {
+		t.Errorf("Unmarshal() got = %+v, %v", got, err)
+	}
+
+	var empty response
+	if data, err := Marshal(&empty); err != nil || len(data) != 0 {
+		t.Errorf("Marshal() of nulls got = % x, %v", data, err)
+	}
+}
+
+func TestUnmarshalErrors(t *testing.T) {
+	var pan struct {
+		PAN null.PAN `emv:"5A"`
+	}
+	err := Unmarshal([]byte{0x5A, 0x08, 0x41, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x12}, &pan)
+	var tagErr *TagError
+	if !errors.Is(err, null.ErrInvalidPANLuhn) || !errors.As(err, &tagErr) || tagErr.Tag != 0x5A {
+		t.Errorf("Unmarshal() PAN error = %v", err)
+	}
+
+	var expiry struct {
+		Expiry null.CardDate `emv:"5F24"`
+	}
+	if err := Unmarshal([]byte{0x5F, 0x24, 0x03, 0x27, 0x13, 0x31}, &expiry); !errors.Is(err, null.ErrInvalidMonth) {
+		t.Errorf("Unmarshal() expiry error = %v, want %v", err, null.ErrInvalidMonth)
+	}
+	if err := Unmarshal([]byte{0x5F, 0x24, 0x01, 0x27}, &expiry); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unmarshal() short expiry error = %v, want %v", err, ErrInvalidValue)
+	}
+
+	var amount struct {
+		Amount null.Int64 `emv:"9F02"`
+	}
+	if err := Unmarshal([]byte{0x9F, 0x02, 0x01, 0x1A}, &amount); !errors.Is(err, ErrInvalidValue) {
+		t.Errorf("Unmarshal() amount error = %v, want %v", err, ErrInvalidValue)
+	}
+
+	var unknown struct {
+		X null.String `emv:"DF01"`
+	}
+	if err := Unmarshal(nil, &unknown); !errors.Is(err, ErrUnknownFormat) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrUnknownFormat)
+	}
+
+	var wrongType struct {
+		Amount null.Float64 `emv:"9F02"`
+	}
+	if err := Unmarshal(nil, &wrongType); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrUnsupportedType)
+	}
+
+	var badTag struct {
+		X null.String `emv:"5A24"`
+	}
+	if err := Unmarshal(nil, &badTag); err == nil {
+		t.Error("Unmarshal() with a bad tag should fail")
+	}
+	if err := Unmarshal(nil, pan); err == nil {
+		t.Error("Unmarshal() into a non-pointer should fail")
+	}
+}
+
+func TestMarshalErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface{}
+		want error
+	}{
+		{"amount too large", &struct {
+			Amount null.Int64 `emv:"9F02"`
+		}{null.Int64From(1e12)}, ErrInvalidValue},
+		{"negative amount", &struct {
+			Amount null.Int64 `emv:"9F02"`
+		}{null.Int64From(-1)}, ErrInvalidValue},
+		{"ATC overflow", &struct {
+			ATC null.Int `emv:"9F36"`
+		}{null.IntFrom(1 << 16)}, ErrInvalidValue},
+		{"name too long", &struct {
+			Name null.String `emv:"5F20"`
+		}{null.StringFrom("ABCDEFGHIJKLMNOPQRSTUVWXYZ0")}, ErrInvalidValue},
+		{"card date in binary", &struct {
+			Expiry null.CardDate `emv:"9F26"`
+		}{}, ErrUnsupportedType},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Marshal(tt.v); !errors.Is(err, tt.want) {
+				t.Errorf("Marshal() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
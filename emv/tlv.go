@@ -0,0 +1,247 @@
+// Package emv decodes and encodes the BER-TLV data objects of EMV chip cards,
+// and maps their tags to the nullable types of package null. A tag absent
+// from the data decodes to a null value, a null value is encoded by leaving
+// its tag out.
+package emv
+
+import (
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// vars
+var (
+	ErrInvalidTag      = errors.New("invalid tag")
+	ErrInvalidLength   = errors.New("invalid length")
+	ErrTruncated       = errors.New("data object is truncated")
+	ErrInvalidValue    = errors.New("invalid value for the tag format")
+	ErrUnsupportedType = errors.New("unsupported Go type for the tag")
+	ErrTooDeep         = errors.New("templates are nested too deeply")
+)
+
+// maxDepth is the number of nested templates Unpack walks into.
+const maxDepth = 16
+
+// Tag is a BER-TLV tag, with its bytes in big endian order, e.g. 0x5F24.
+type Tag uint32
+
+// ParseTag parses the hexadecimal form of a tag, e.g. 5F24.
+func ParseTag(s string) (Tag, error) {
+	b, err := hex.DecodeString(s)
+	if err != nil || len(b) == 0 || len(b) > 4 {
+		return 0, ErrInvalidTag
+	}
+	var t Tag
+	for _, c := range b {
+		t = t<<8 | Tag(c)
+	}
+	if _, err := t.bytes(); err != nil {
+		return 0, err
+	}
+	return t, nil
+}
+
+// Constructed reports whether the value of t is made of data objects.
+func (t Tag) Constructed() bool {
+	b, err := t.bytes()
+	return err == nil && b[0]&0x20 != 0
+}
+
+// String returns the hexadecimal form of t, e.g. 5F24.
+func (t Tag) String() string {
+	b, err := t.bytes()
+	if err != nil {
+		return "Tag(" + strconv.FormatUint(uint64(t), 16) + ")"
+	}
+	return strings.ToUpper(hex.EncodeToString(b))
+}
+
+// bytes returns the encoding of t, checking that the subsequent bytes are
+// announced by the first one and continued by their high bit.
+func (t Tag) bytes() ([]byte, error) {
+	var b []byte
+	for v := t; v != 0; v >>= 8 {
+		b = append([]byte{byte(v)}, b...)
+	}
+	if len(b) == 0 || (len(b) > 1) != (b[0]&0x1f == 0x1f) {
+		return nil, ErrInvalidTag
+	}
+	for i := 1; i < len(b); i++ {
+		if (b[i]&0x80 != 0) != (i < len(b)-1) {
+			return nil, ErrInvalidTag
+		}
+	}
+	return b, nil
+}
+
+// TLV is a data object. A primitive object has a Value, a constructed one
+// has Children.
+type TLV struct {
+	Tag      Tag
+	Value    []byte
+	Children []TLV
+}
+
+// TagError reports the data object that could not be decoded, encoded or
+// converted.
+type TagError struct {
+	Tag Tag
+	// Offset is the byte offset of the data object in the decoded data,
+	// or -1 when encoding or converting.
+	Offset int
+	Err    error
+}
+
+// Error implements error.
+func (e *TagError) Error() string {
+	msg := "emv: "
+	if e.Tag != 0 {
+		msg += "tag " + e.Tag.String() + " "
+	}
+	if e.Offset >= 0 {
+		msg += "at offset " + strconv.Itoa(e.Offset) + " "
+	}
+	return strings.TrimSuffix(msg, " ") + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying cause.
+func (e *TagError) Unwrap() error {
+	return e.Err
+}
+
+// Unpack decodes the data objects of data, walking into constructed ones.
+// The 00 and FF padding bytes allowed between data objects are skipped.
+// A template nested deeper than 16 levels fails with ErrTooDeep.
+// Errors are of type *TagError.
+func Unpack(data []byte) ([]TLV, error) {
+	return unpack(data, 0, 0)
+}
+
+func unpack(data []byte, base, depth int) ([]TLV, error) {
+	var tlvs []TLV
+	for i := 0; i < len(data); {
+		if data[i] == 0x00 || data[i] == 0xff {
+			i++
+			continue
+		}
+		start := i
+		tag, n, err := readTag(data[i:])
+		if err != nil {
+			return nil, &TagError{Offset: base + start, Err: err}
+		}
+		i += n
+		length, n, err := readLength(data[i:])
+		if err != nil {
+			return nil, &TagError{Tag: tag, Offset: base + start, Err: err}
+		}
+		i += n
+		if len(data)-i < length {
+			return nil, &TagError{Tag: tag, Offset: base + start, Err: ErrTruncated}
+		}
+		value := data[i : i+length]
+		tlv := TLV{Tag: tag}
+		if tag.Constructed() {
+			if depth == maxDepth {
+				return nil, &TagError{Tag: tag, Offset: base + start, Err: ErrTooDeep}
+			}
+			if tlv.Children, err = unpack(value, base+i, depth+1); err != nil {
+				return nil, err
+			}
+		} else {
+			tlv.Value = append([]byte{}, value...)
+		}
+		tlvs = append(tlvs, tlv)
+		i += length
+	}
+	return tlvs, nil
+}
+
+func readTag(data []byte) (Tag, int, error) {
+	t := Tag(data[0])
+	if data[0]&0x1f != 0x1f {
+		return t, 1, nil
+	}
+	for i := 1; i < len(data); i++ {
+		if i == 4 {
+			return 0, 0, ErrInvalidTag
+		}
+		t = t<<8 | Tag(data[i])
+		if data[i]&0x80 == 0 {
+			return t, i + 1, nil
+		}
+	}
+	return 0, 0, ErrTruncated
+}
+
+func readLength(data []byte) (int, int, error) {
+	if len(data) == 0 {
+		return 0, 0, ErrTruncated
+	}
+	if data[0] < 0x80 {
+		return int(data[0]), 1, nil
+	}
+	n := int(data[0] & 0x7f)
+	if n == 0 || n > 3 {
+		return 0, 0, ErrInvalidLength
+	}
+	if len(data) <= n {
+		return 0, 0, ErrTruncated
+	}
+	length := 0
+	for _, c := range data[1 : n+1] {
+		length = length<<8 | int(c)
+	}
+	return length, n + 1, nil
+}
+
+// Pack encodes tlvs. A constructed data object is encoded from its Children.
+// Errors are of type *TagError.
+func Pack(tlvs []TLV) ([]byte, error) {
+	var out []byte
+	for _, tlv := range tlvs {
+		tag, err := tlv.Tag.bytes()
+		if err != nil {
+			return nil, &TagError{Tag: tlv.Tag, Offset: -1, Err: err}
+		}
+		value := tlv.Value
+		if tlv.Tag.Constructed() {
+			if value, err = Pack(tlv.Children); err != nil {
+				return nil, err
+			}
+		}
+		if len(value) > 0xffffff {
+			return nil, &TagError{Tag: tlv.Tag, Offset: -1, Err: ErrInvalidLength}
+		}
+		out = append(out, tag...)
+		out = appendLength(out, len(value))
+		out = append(out, value...)
+	}
+	return out, nil
+}
+
+func appendLength(out []byte, n int) []byte {
+	switch {
+	case n < 0x80:
+		return append(out, byte(n))
+	case n <= 0xff:
+		return append(out, 0x81, byte(n))
+	case n <= 0xffff:
+		return append(out, 0x82, byte(n>>8), byte(n))
+	}
+	return append(out, 0x83, byte(n>>16), byte(n>>8), byte(n))
+}
+
+// Find returns the first data object with tag, searching tlvs depth first.
+func Find(tlvs []TLV, tag Tag) (TLV, bool) {
+	for _, tlv := range tlvs {
+		if tlv.Tag == tag {
+			return tlv, true
+		}
+		if found, ok := Find(tlv.Children, tag); ok {
+			return found, true
+		}
+	}
+	return TLV{}, false
+}
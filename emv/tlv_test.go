@@ -0,0 +1,136 @@
+package emv
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testData is a read record template followed by transaction data.
+var testData = []byte{
+	0x70, 0x14,
+	0x5A, 0x08, 0x41, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11,
+	0x5F, 0x24, 0x03, 0x27, 0x09, 0x30,
+	0x5F, 0x34, 0x01, 0x01,
+	0x9F, 0x02, 0x06, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00,
+	0x9F, 0x1A, 0x02, 0x08, 0x40,
+}
+
+func TestUnpack(t *testing.T) {
+	tlvs, err := Unpack(testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []TLV{
+		{Tag: 0x70, Children: []TLV{
+			{Tag: 0x5A, Value: []byte{0x41, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11, 0x11}},
+			{Tag: 0x5F24, Value: []byte{0x27, 0x09, 0x30}},
+			{Tag: 0x5F34, Value: []byte{0x01}},
+		}},
+		{Tag: 0x9F02, Value: []byte{0x00, 0x00, 0x00, 0x00, 0x10, 0x00}},
+		{Tag: 0x9F1A, Value: []byte{0x08, 0x40}},
+	}
+	if !reflect.DeepEqual(tlvs, want) {
+		t.Errorf("Unpack() got = %+v, want %+v", tlvs, want)
+	}
+
+	data, err := Pack(tlvs)
+	if err != nil || !bytes.Equal(data, testData) {
+		t.Errorf("Pack() got = % x, %v, want % x", data, err, testData)
+	}
+
+	if tlv, ok := Find(tlvs, 0x5F24); !ok || !bytes.Equal(tlv.Value, []byte{0x27, 0x09, 0x30}) {
+		t.Errorf("Find() got = %+v, %v", tlv, ok)
+	}
+	if _, ok := Find(tlvs, 0x9F36); ok {
+		t.Error("Find() of an absent tag should fail")
+	}
+}
+
+func TestUnpackPaddingAndLongLength(t *testing.T) {
+	value := bytes.Repeat([]byte{0xab}, 200)
+	data, err := Pack([]TLV{{Tag: 0x9F10, Value: value}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(data[:4], []byte{0x9F, 0x10, 0x81, 200}) {
+		t.Errorf("Pack() header got = % x", data[:4])
+	}
+	tlvs, err := Unpack(append([]byte{0x00, 0xff}, append(data, 0x00)...))
+	if err != nil || len(tlvs) != 1 || !bytes.Equal(tlvs[0].Value, value) {
+		t.Errorf("Unpack() got = %v, %v", tlvs, err)
+	}
+}
+
+func TestUnpackErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   []byte
+		offset int
+		want   error
+	}{
+		{"truncated tag", []byte{0x9F}, 0, ErrTruncated},
+		{"tag too long", []byte{0x9F, 0x81, 0x81, 0x81, 0x01}, 0, ErrInvalidTag},
+		{"missing length", []byte{0x5A}, 0, ErrTruncated},
+		{"indefinite length", []byte{0x5A, 0x80}, 0, ErrInvalidLength},
+		{"truncated length", []byte{0x5A, 0x82, 0x01}, 0, ErrTruncated},
+		{"truncated value", []byte{0x5A, 0x02, 0x01}, 0, ErrTruncated},
+		{"truncated child", []byte{0x5F, 0x34, 0x01, 0x01, 0x70, 0x02, 0x5A, 0x05}, 6, ErrTruncated},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Unpack(tt.data)
+			var tagErr *TagError
+			if !errors.As(err, &tagErr) || tagErr.Offset != tt.offset || !errors.Is(err, tt.want) {
+				t.Errorf("Unpack() error = %v, want %v at %d", err, tt.want, tt.offset)
+			}
+		})
+	}
+}
+
+func TestUnpackDepth(t *testing.T) {
+	nested := func(n int) []byte {
+		var data []byte
+		for i := 0; i < n; i++ {
+			data = append([]byte{0x70, byte(len(data))}, data...)
+		}
+		return data
+	}
+	if _, err := Unpack(nested(maxDepth)); err != nil {
+		t.Errorf("Unpack() of %d templates error = %v", maxDepth, err)
+	}
+	_, err := Unpack(nested(maxDepth + 1))
+	var tagErr *TagError
+	if !errors.As(err, &tagErr) || tagErr.Offset != 2*maxDepth || !errors.Is(err, ErrTooDeep) {
+		t.Errorf("Unpack() error = %v, want %v at %d", err, ErrTooDeep, 2*maxDepth)
+	}
+}
+
+func TestTag(t *testing.T) {
+	tests := []struct {
+		s           string
+		tag         Tag
+		constructed bool
+	}{
+		{"5A", 0x5A, false},
+		{"5F24", 0x5F24, false},
+		{"70", 0x70, true},
+		{"BF0C", 0xBF0C, true},
+		{"9F8101", 0x9F8101, false},
+	}
+	for _, tt := range tests {
+		tag, err := ParseTag(tt.s)
+		if err != nil || tag != tt.tag || tag.Constructed() != tt.constructed || tag.String() != tt.s {
+			t.Errorf("ParseTag(%q) got = %v, %v, constructed %v", tt.s, tag, err, tag.Constructed())
+		}
+	}
+	for _, s := range []string{"", "zz", "5F", "5A24", "9F81", "0102030405"} {
+		if _, err := ParseTag(s); !errors.Is(err, ErrInvalidTag) {
+			t.Errorf("ParseTag(%q) error = %v, want %v", s, err, ErrInvalidTag)
+		}
+	}
+	if _, err := Pack([]TLV{{Tag: 0x5A24}}); !errors.Is(err, ErrInvalidTag) {
+		t.Errorf("Pack() error = %v, want %v", err, ErrInvalidTag)
+	}
+}
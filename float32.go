@@ -99,6 +99,23 @@ func (f Float32) Ptr() *float32 {
 	return &f.Float32
 }
 
+// ValueOr returns this Float32's value, or def if this Float32 is null.
+func (f Float32) ValueOr(def float32) float32 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float32
+}
+
+// ValueOrZero returns this Float32's value, or the zero value of float32 if this
+// Float32 is null.
+func (f Float32) ValueOrZero() float32 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float32
+}
+
 // IsZero returns true for invalid Float32s, for future omitempty support (Go 1.4?)
 func (f Float32) IsZero() bool {
 	return !f.Valid
@@ -132,3 +149,83 @@ func (f *Float32) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		f.Valid = true
 	}
 }
+
+// Add returns f + g, or null if either is null.
+func (f Float32) Add(g Float32) Float32 {
+	x, ok, _ := binary(f.Float32, f.Valid, g.Float32, g.Valid, add[float32])
+	return NewFloat32(x, ok)
+}
+
+// Sub returns f - g, or null if either is null.
+func (f Float32) Sub(g Float32) Float32 {
+	x, ok, _ := binary(f.Float32, f.Valid, g.Float32, g.Valid, sub[float32])
+	return NewFloat32(x, ok)
+}
+
+// Mul returns f * g, or null if either is null.
+func (f Float32) Mul(g Float32) Float32 {
+	x, ok, _ := binary(f.Float32, f.Valid, g.Float32, g.Valid, mul[float32])
+	return NewFloat32(x, ok)
+}
+
+// Div returns f / g, or null if either is null.
+// It is null as well if g is zero, see DivChecked.
+func (f Float32) Div(g Float32) Float32 {
+	x, ok, _ := binary(f.Float32, f.Valid, g.Float32, g.Valid, div[float32])
+	return NewFloat32(x, ok)
+}
+
+// Mod returns f modulo g, or null if either is null.
+// It is the remainder of math.Mod, null as well if g is zero.
+func (f Float32) Mod(g Float32) Float32 {
+	x, ok, _ := binary(f.Float32, f.Valid, g.Float32, g.Valid, modFloat[float32])
+	return NewFloat32(x, ok)
+}
+
+// Neg returns -f, or null if f is null.
+func (f Float32) Neg() Float32 {
+	x, ok, _ := unary(f.Float32, f.Valid, neg[float32])
+	return NewFloat32(x, ok)
+}
+
+// Abs returns the absolute value of f, or null if f is null.
+func (f Float32) Abs() Float32 {
+	x, ok, _ := unary(f.Float32, f.Valid, abs[float32])
+	return NewFloat32(x, ok)
+}
+
+// AddChecked returns f + g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float32) AddChecked(g Float32) (Float32, error) {
+	x, ok, err := binary(f.Float32, f.Valid, g.Float32, g.Valid, finite(add[float32]))
+	return NewFloat32(x, ok), err
+}
+
+// SubChecked returns f - g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float32) SubChecked(g Float32) (Float32, error) {
+	x, ok, err := binary(f.Float32, f.Valid, g.Float32, g.Valid, finite(sub[float32]))
+	return NewFloat32(x, ok), err
+}
+
+// MulChecked returns f * g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float32) MulChecked(g Float32) (Float32, error) {
+	x, ok, err := binary(f.Float32, f.Valid, g.Float32, g.Valid, finite(mul[float32]))
+	return NewFloat32(x, ok), err
+}
+
+// DivChecked returns f / g, or null if either is null.
+// It fails with ErrDivisionByZero if g is zero and with ErrOverflow for an
+// infinite result of finite operands.
+func (f Float32) DivChecked(g Float32) (Float32, error) {
+	x, ok, err := binary(f.Float32, f.Valid, g.Float32, g.Valid, divFloatChecked[float32])
+	return NewFloat32(x, ok), err
+}
+
+// ModChecked returns f modulo g, or null if either is null.
+// It fails with ErrDivisionByZero if g is zero.
+func (f Float32) ModChecked(g Float32) (Float32, error) {
+	x, ok, err := binary(f.Float32, f.Valid, g.Float32, g.Valid, modFloatChecked[float32])
+	return NewFloat32(x, ok), err
+}
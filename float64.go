@@ -94,6 +94,23 @@ func (f Float64) Ptr() *float64 {
 	return &f.Float64
 }
 
+// ValueOr returns this Float64's value, or def if this Float64 is null.
+func (f Float64) ValueOr(def float64) float64 {
+	if !f.Valid {
+		return def
+	}
+	return f.Float64
+}
+
+// ValueOrZero returns this Float64's value, or the zero value of float64 if this
+// Float64 is null.
+func (f Float64) ValueOrZero() float64 {
+	if !f.Valid {
+		return 0
+	}
+	return f.Float64
+}
+
 // IsZero returns true for invalid Float64s, for future omitempty support (Go 1.4?)
 func (f Float64) IsZero() bool {
 	return !f.Valid
@@ -127,3 +144,83 @@ func (f *Float64) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		f.Valid = true
 	}
 }
+
+// Add returns f + g, or null if either is null.
+func (f Float64) Add(g Float64) Float64 {
+	x, ok, _ := binary(f.Float64, f.Valid, g.Float64, g.Valid, add[float64])
+	return NewFloat64(x, ok)
+}
+
+// Sub returns f - g, or null if either is null.
+func (f Float64) Sub(g Float64) Float64 {
+	x, ok, _ := binary(f.Float64, f.Valid, g.Float64, g.Valid, sub[float64])
+	return NewFloat64(x, ok)
+}
+
+// Mul returns f * g, or null if either is null.
+func (f Float64) Mul(g Float64) Float64 {
+	x, ok, _ := binary(f.Float64, f.Valid, g.Float64, g.Valid, mul[float64])
+	return NewFloat64(x, ok)
+}
+
+// Div returns f / g, or null if either is null.
+// It is null as well if g is zero, see DivChecked.
+func (f Float64) Div(g Float64) Float64 {
+	x, ok, _ := binary(f.Float64, f.Valid, g.Float64, g.Valid, div[float64])
+	return NewFloat64(x, ok)
+}
+
+// Mod returns f modulo g, or null if either is null.
+// It is the remainder of math.Mod, null as well if g is zero.
+func (f Float64) Mod(g Float64) Float64 {
+	x, ok, _ := binary(f.Float64, f.Valid, g.Float64, g.Valid, modFloat[float64])
+	return NewFloat64(x, ok)
+}
+
+// Neg returns -f, or null if f is null.
+func (f Float64) Neg() Float64 {
+	x, ok, _ := unary(f.Float64, f.Valid, neg[float64])
+	return NewFloat64(x, ok)
+}
+
+// Abs returns the absolute value of f, or null if f is null.
+func (f Float64) Abs() Float64 {
+	x, ok, _ := unary(f.Float64, f.Valid, abs[float64])
+	return NewFloat64(x, ok)
+}
+
+// AddChecked returns f + g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float64) AddChecked(g Float64) (Float64, error) {
+	x, ok, err := binary(f.Float64, f.Valid, g.Float64, g.Valid, finite(add[float64]))
+	return NewFloat64(x, ok), err
+}
+
+// SubChecked returns f - g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float64) SubChecked(g Float64) (Float64, error) {
+	x, ok, err := binary(f.Float64, f.Valid, g.Float64, g.Valid, finite(sub[float64]))
+	return NewFloat64(x, ok), err
+}
+
+// MulChecked returns f * g, or null if either is null.
+// It fails with ErrOverflow for an infinite result of finite operands.
+func (f Float64) MulChecked(g Float64) (Float64, error) {
+	x, ok, err := binary(f.Float64, f.Valid, g.Float64, g.Valid, finite(mul[float64]))
+	return NewFloat64(x, ok), err
+}
+
+// DivChecked returns f / g, or null if either is null.
+// It fails with ErrDivisionByZero if g is zero and with ErrOverflow for an
+// infinite result of finite operands.
+func (f Float64) DivChecked(g Float64) (Float64, error) {
+	x, ok, err := binary(f.Float64, f.Valid, g.Float64, g.Valid, divFloatChecked[float64])
+	return NewFloat64(x, ok), err
+}
+
+// ModChecked returns f modulo g, or null if either is null.
+// It fails with ErrDivisionByZero if g is zero.
+func (f Float64) ModChecked(g Float64) (Float64, error) {
+	x, ok, err := binary(f.Float64, f.Valid, g.Float64, g.Valid, modFloatChecked[float64])
+	return NewFloat64(x, ok), err
+}
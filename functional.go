@@ -0,0 +1,74 @@
+package null
+
+import (
+	"bytes"
+	"reflect"
+)
+
+// Map returns f applied to the value of n, any type of the package with a
+// Ptr method such as Int64, String or CardDate, or null if n is null.
+//
+//	s := null.Map(i, func(v int64) string { return strconv.FormatInt(v, 10) })
+//
+// Use FlatMap to get one of the concrete types, or a null result.
+func Map[N interface{ Ptr() *T }, T, U any](n N, f func(T) U) Value[U] {
+	p := n.Ptr()
+	if p == nil {
+		return Value[U]{}
+	}
+	return ValueFrom(f(*p))
+}
+
+// FlatMap returns f applied to the value of n, or the zero value of R, which
+// is null for the types of the package, if n is null.
+//
+//	s := null.FlatMap(i, func(v int64) null.String {
+//		return null.NewString(strconv.FormatInt(v, 10), v != 0)
+//	})
+func FlatMap[N interface{ Ptr() *T }, T, R any](n N, f func(T) R) R {
+	p := n.Ptr()
+	if p == nil {
+		var zero R
+		return zero
+	}
+	return f(*p)
+}
+
+// Coalesce returns the first of values that is not null, as SQL's COALESCE,
+// or null if they all are.
+func Coalesce[N interface{ IsZero() bool }](values ...N) N {
+	for _, v := range values {
+		if !v.IsZero() {
+			return v
+		}
+	}
+	var zero N
+	return zero
+}
+
+// NullIf returns null if a and b are equal and not null, as SQL's NULLIF,
+// and a otherwise. Times are equal if they are the same instant, Bytes and
+// JSON if they hold the same bytes.
+func NullIf[N interface{ IsZero() bool }](a, b N) N {
+	if a.IsZero() || b.IsZero() || !nullEqual(a, b) {
+		return a
+	}
+	var zero N
+	return zero
+}
+
+// nullEqual reports whether a and b, two valid values of the same type,
+// hold equal values.
+func nullEqual(a, b interface{}) bool {
+	switch a := a.(type) {
+	case Time:
+		return a.Time.Equal(b.(Time).Time)
+	case CardDate:
+		return a.Equal(b.(CardDate))
+	case Bytes:
+		return bytes.Equal(a.Bytes, b.(Bytes).Bytes)
+	case JSON:
+		return bytes.Equal(a.JSON, b.(JSON).JSON)
+	}
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,122 @@
+package null
+
+import (
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestValueOr(t *testing.T) {
+	if v := Int64From(5).ValueOr(7); v != 5 {
+		t.Errorf("ValueOr = %d, want 5", v)
+	}
+	if v := NewInt64(5, false).ValueOr(7); v != 7 {
+		t.Errorf("ValueOr of null = %d, want 7", v)
+	}
+	if v := NewInt64(5, false).ValueOrZero(); v != 0 {
+		t.Errorf("ValueOrZero of null = %d, want 0", v)
+	}
+	if v := NewString("x", false).ValueOr("def"); v != "def" {
+		t.Errorf("ValueOr of null = %q, want def", v)
+	}
+	if v := BoolFrom(true).ValueOrZero(); !v {
+		t.Error("ValueOrZero of true = false")
+	}
+	if v := NewJSON(NullBytes, false).ValueOrZero(); v != nil {
+		t.Errorf("ValueOrZero of null JSON = %s, want nil", v)
+	}
+	if v := NewBytes([]byte("x"), false).ValueOr([]byte("y")); string(v) != "y" {
+		t.Errorf("ValueOr of null Bytes = %s, want y", v)
+	}
+	if v := NewTime(timeValue, false).ValueOrZero(); !v.IsZero() {
+		t.Errorf("ValueOrZero of null Time = %v", v)
+	}
+	exp := time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC)
+	if v := CardDateFrom(exp).ValueOr(time.Time{}); !v.Equal(exp) {
+		t.Errorf("CardDate ValueOr = %v, want %v", v, exp)
+	}
+	if v := NewCardDate(exp, false).ValueOrZero(); !v.IsZero() {
+		t.Errorf("ValueOrZero of null CardDate = %v", v)
+	}
+	if p := NewCardDate(exp, false).Ptr(); p != nil {
+		t.Errorf("Ptr of null CardDate = %v", p)
+	}
+	if v := ValueFrom(1.5).ValueOr(2); v != 1.5 {
+		t.Errorf("Value ValueOr = %v, want 1.5", v)
+	}
+	if v := (Value[uint8]{}).ValueOrZero(); v != 0 {
+		t.Errorf("Value ValueOrZero = %v, want 0", v)
+	}
+	if v := float32(Uint8From(3).ValueOr(1)) + Float32From(1).ValueOrZero(); v != 4 {
+		t.Errorf("numeric ValueOr = %v, want 4", v)
+	}
+}
+
+func TestMap(t *testing.T) {
+	format := func(v int64) string { return strconv.FormatInt(v, 10) }
+	if s := Map(Int64From(42), format); s != ValueFrom("42") {
+		t.Errorf("Map = %+v, want 42", s)
+	}
+	if s := Map(NewInt64(42, false), format); s.Valid {
+		t.Errorf("Map of null = %+v, want null", s)
+	}
+	year := func(t time.Time) int { return t.Year() }
+	if y := Map(CardDateFrom(time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC)), year); y.V != 2027 {
+		t.Errorf("Map of CardDate = %+v, want 2027", y)
+	}
+
+	nonEmpty := func(s string) String { return NewString(s, s != "") }
+	if s := FlatMap(StringFrom(""), nonEmpty); s.Valid {
+		t.Errorf("FlatMap = %+v, want null", s)
+	}
+	if s := FlatMap(StringFrom("a"), nonEmpty); s != StringFrom("a") {
+		t.Errorf("FlatMap = %+v, want a", s)
+	}
+	if s := FlatMap(NewString("a", false), nonEmpty); s.Valid {
+		t.Errorf("FlatMap of null = %+v, want null", s)
+	}
+}
+
+func TestCoalesce(t *testing.T) {
+	if v := Coalesce(NewInt8(1, false), Int8From(2), Int8From(3)); v != Int8From(2) {
+		t.Errorf("Coalesce = %+v, want 2", v)
+	}
+	if v := Coalesce(NewString("a", false), String{}); v.Valid {
+		t.Errorf("Coalesce of nulls = %+v, want null", v)
+	}
+	if v := Coalesce[Bool](); v.Valid {
+		t.Errorf("Coalesce() = %+v, want null", v)
+	}
+	if v := Coalesce(JSON{}, JSONFrom([]byte("{}"))); string(v.JSON) != "{}" {
+		t.Errorf("Coalesce of JSON = %+v", v)
+	}
+}
+
+func TestNullIf(t *testing.T) {
+	if v := NullIf(Int64From(1), Int64From(1)); v.Valid {
+		t.Errorf("NullIf(1, 1) = %+v, want null", v)
+	}
+	if v := NullIf(Int64From(1), Int64From(2)); v != Int64From(1) {
+		t.Errorf("NullIf(1, 2) = %+v, want 1", v)
+	}
+	if v := NullIf(Int64From(1), Int64{}); v != Int64From(1) {
+		t.Errorf("NullIf(1, null) = %+v, want 1", v)
+	}
+	if v := NullIf(StringFrom(""), StringFrom("")); v.Valid {
+		t.Errorf(`NullIf("", "") = %+v, want null`, v)
+	}
+	utc := TimeFrom(timeValue.UTC())
+	if v := NullIf(TimeFrom(timeValue.In(time.FixedZone("X", 3600))), utc); v.Valid {
+		t.Errorf("NullIf of the same instant = %+v, want null", v)
+	}
+	if v := NullIf(BytesFrom([]byte("a")), BytesFrom([]byte("a"))); v.Valid {
+		t.Errorf("NullIf of equal Bytes = %+v, want null", v)
+	}
+	if v := NullIf(JSONFrom([]byte("1")), JSONFrom([]byte("2"))); !v.Valid {
+		t.Errorf("NullIf of different JSON = %+v, want valid", v)
+	}
+	d := CardDateFrom(time.Date(2027, 9, 1, 0, 0, 0, 0, time.UTC))
+	if v := NullIf(d, d); v.Valid {
+		t.Errorf("NullIf of equal CardDates = %+v, want null", v)
+	}
+}
@@ -1,5 +1,12 @@
 module github.com/metricsglobal/null
 
-go 1.16
+go 1.18
 
 require github.com/volatiletech/randomize v0.0.1
+
+require (
+	github.com/friendsofgo/errors v0.9.2 // indirect
+	github.com/gofrs/uuid v3.2.0+incompatible // indirect
+	github.com/volatiletech/inflect v0.0.1 // indirect
+	github.com/volatiletech/strmangle v0.0.1 // indirect
+)
@@ -100,6 +100,23 @@ func (i Int) Ptr() *int {
 	return &i.Int
 }
 
+// ValueOr returns this Int's value, or def if this Int is null.
+func (i Int) ValueOr(def int) int {
+	if !i.Valid {
+		return def
+	}
+	return i.Int
+}
+
+// ValueOrZero returns this Int's value, or the zero value of int if this
+// Int is null.
+func (i Int) ValueOrZero() int {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int
+}
+
 // IsZero returns true for invalid Ints, for future omitempty support (Go 1.4?)
 func (i Int) IsZero() bool {
 	return !i.Valid
@@ -133,3 +150,103 @@ func (i *Int) Randomize(nextInt func() int64, fieldType string, shouldBeNull boo
 		i.Valid = true
 	}
 }
+
+// Add returns i + j, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (i Int) Add(j Int) Int {
+	x, ok, _ := binary(i.Int, i.Valid, j.Int, j.Valid, add[int])
+	return NewInt(x, ok)
+}
+
+// Sub returns i - j, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (i Int) Sub(j Int) Int {
+	x, ok, _ := binary(i.Int, i.Valid, j.Int, j.Valid, sub[int])
+	return NewInt(x, ok)
+}
+
+// Mul returns i * j, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (i Int) Mul(j Int) Int {
+	x, ok, _ := binary(i.Int, i.Valid, j.Int, j.Valid, mul[int])
+	return NewInt(x, ok)
+}
+
+// Div returns i / j, or null if either is null.
+// It is null as well if j is zero, see DivChecked.
+func (i Int) Div(j Int) Int {
+	x, ok, _ := binary(i.Int, i.Valid, j.Int, j.Valid, div[int])
+	return NewInt(x, ok)
+}
+
+// Mod returns i % j, or null if either is null.
+// It is null as well if j is zero, see ModChecked.
+func (i Int) Mod(j Int) Int {
+	x, ok, _ := binary(i.Int, i.Valid, j.Int, j.Valid, mod[int])
+	return NewInt(x, ok)
+}
+
+// Neg returns -i, or null if i is null.
+// It wraps around on overflow, see NegChecked.
+func (i Int) Neg() Int {
+	x, ok, _ := unary(i.Int, i.Valid, neg[int])
+	return NewInt(x, ok)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+// The absolute value of the most negative int wraps around to itself,
+// see AbsChecked.
+func (i Int) Abs() Int {
+	x, ok, _ := unary(i.Int, i.Valid, abs[int])
+	return NewInt(x, ok)
+}
+
+// AddChecked returns i + j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int.
+func (i Int) AddChecked(j Int) (Int, error) {
+	x, ok, err := binary(i.Int, i.Valid, j.Int, j.Valid, addChecked[int])
+	return NewInt(x, ok), err
+}
+
+// SubChecked returns i - j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int.
+func (i Int) SubChecked(j Int) (Int, error) {
+	x, ok, err := binary(i.Int, i.Valid, j.Int, j.Valid, subChecked[int])
+	return NewInt(x, ok), err
+}
+
+// MulChecked returns i * j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int.
+func (i Int) MulChecked(j Int) (Int, error) {
+	x, ok, err := binary(i.Int, i.Valid, j.Int, j.Valid, mulChecked[int])
+	return NewInt(x, ok), err
+}
+
+// DivChecked returns i / j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero and with ErrOverflow
+// for the most negative int divided by -1.
+func (i Int) DivChecked(j Int) (Int, error) {
+	x, ok, err := binary(i.Int, i.Valid, j.Int, j.Valid, divChecked[int])
+	return NewInt(x, ok), err
+}
+
+// ModChecked returns i % j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero.
+func (i Int) ModChecked(j Int) (Int, error) {
+	x, ok, err := binary(i.Int, i.Valid, j.Int, j.Valid, modChecked[int])
+	return NewInt(x, ok), err
+}
+
+// NegChecked returns -i, or null if i is null.
+// It fails with ErrOverflow for the most negative int.
+func (i Int) NegChecked() (Int, error) {
+	x, ok, err := unary(i.Int, i.Valid, negChecked[int])
+	return NewInt(x, ok), err
+}
+
+// AbsChecked returns the absolute value of i, or null if i is null.
+// It fails with ErrOverflow for the most negative int.
+func (i Int) AbsChecked() (Int, error) {
+	x, ok, err := unary(i.Int, i.Valid, absChecked[int])
+	return NewInt(x, ok), err
+}
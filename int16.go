@@ -105,6 +105,23 @@ func (i Int16) Ptr() *int16 {
 	return &i.Int16
 }
 
+// ValueOr returns this Int16's value, or def if this Int16 is null.
+func (i Int16) ValueOr(def int16) int16 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int16
+}
+
+// ValueOrZero returns this Int16's value, or the zero value of int16 if this
+// Int16 is null.
+func (i Int16) ValueOrZero() int16 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int16
+}
+
 // IsZero returns true for invalid Int16's, for future omitempty support (Go 1.4?)
 func (i Int16) IsZero() bool {
 	return !i.Valid
@@ -138,3 +155,103 @@ func (i *Int16) Randomize(nextInt func() int64, fieldType string, shouldBeNull b
 		i.Valid = true
 	}
 }
+
+// Add returns i + j, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (i Int16) Add(j Int16) Int16 {
+	x, ok, _ := binary(i.Int16, i.Valid, j.Int16, j.Valid, add[int16])
+	return NewInt16(x, ok)
+}
+
+// Sub returns i - j, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (i Int16) Sub(j Int16) Int16 {
+	x, ok, _ := binary(i.Int16, i.Valid, j.Int16, j.Valid, sub[int16])
+	return NewInt16(x, ok)
+}
+
+// Mul returns i * j, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (i Int16) Mul(j Int16) Int16 {
+	x, ok, _ := binary(i.Int16, i.Valid, j.Int16, j.Valid, mul[int16])
+	return NewInt16(x, ok)
+}
+
+// Div returns i / j, or null if either is null.
+// It is null as well if j is zero, see DivChecked.
+func (i Int16) Div(j Int16) Int16 {
+	x, ok, _ := binary(i.Int16, i.Valid, j.Int16, j.Valid, div[int16])
+	return NewInt16(x, ok)
+}
+
+// Mod returns i % j, or null if either is null.
+// It is null as well if j is zero, see ModChecked.
+func (i Int16) Mod(j Int16) Int16 {
+	x, ok, _ := binary(i.Int16, i.Valid, j.Int16, j.Valid, mod[int16])
+	return NewInt16(x, ok)
+}
+
+// Neg returns -i, or null if i is null.
+// It wraps around on overflow, see NegChecked.
+func (i Int16) Neg() Int16 {
+	x, ok, _ := unary(i.Int16, i.Valid, neg[int16])
+	return NewInt16(x, ok)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+// The absolute value of the most negative int16 wraps around to itself,
+// see AbsChecked.
+func (i Int16) Abs() Int16 {
+	x, ok, _ := unary(i.Int16, i.Valid, abs[int16])
+	return NewInt16(x, ok)
+}
+
+// AddChecked returns i + j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int16.
+func (i Int16) AddChecked(j Int16) (Int16, error) {
+	x, ok, err := binary(i.Int16, i.Valid, j.Int16, j.Valid, addChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// SubChecked returns i - j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int16.
+func (i Int16) SubChecked(j Int16) (Int16, error) {
+	x, ok, err := binary(i.Int16, i.Valid, j.Int16, j.Valid, subChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// MulChecked returns i * j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int16.
+func (i Int16) MulChecked(j Int16) (Int16, error) {
+	x, ok, err := binary(i.Int16, i.Valid, j.Int16, j.Valid, mulChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// DivChecked returns i / j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero and with ErrOverflow
+// for the most negative int16 divided by -1.
+func (i Int16) DivChecked(j Int16) (Int16, error) {
+	x, ok, err := binary(i.Int16, i.Valid, j.Int16, j.Valid, divChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// ModChecked returns i % j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero.
+func (i Int16) ModChecked(j Int16) (Int16, error) {
+	x, ok, err := binary(i.Int16, i.Valid, j.Int16, j.Valid, modChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// NegChecked returns -i, or null if i is null.
+// It fails with ErrOverflow for the most negative int16.
+func (i Int16) NegChecked() (Int16, error) {
+	x, ok, err := unary(i.Int16, i.Valid, negChecked[int16])
+	return NewInt16(x, ok), err
+}
+
+// AbsChecked returns the absolute value of i, or null if i is null.
+// It fails with ErrOverflow for the most negative int16.
+func (i Int16) AbsChecked() (Int16, error) {
+	x, ok, err := unary(i.Int16, i.Valid, absChecked[int16])
+	return NewInt16(x, ok), err
+}
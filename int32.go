@@ -106,6 +106,23 @@ func (i Int32) Ptr() *int32 {
 	return &i.Int32
 }
 
+// ValueOr returns this Int32's value, or def if this Int32 is null.
+func (i Int32) ValueOr(def int32) int32 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int32
+}
+
+// ValueOrZero returns this Int32's value, or the zero value of int32 if this
+// Int32 is null.
+func (i Int32) ValueOrZero() int32 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int32
+}
+
 // IsZero returns true for invalid Int32's, for future omitempty support (Go 1.4?)
 func (i Int32) IsZero() bool {
 	return !i.Valid
@@ -145,3 +162,103 @@ func (i *Int32) Randomize(nextInt func() int64, fieldType string, shouldBeNull b
 		i.Valid = true
 	}
 }
+
+// Add returns i + j, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (i Int32) Add(j Int32) Int32 {
+	x, ok, _ := binary(i.Int32, i.Valid, j.Int32, j.Valid, add[int32])
+	return NewInt32(x, ok)
+}
+
+// Sub returns i - j, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (i Int32) Sub(j Int32) Int32 {
+	x, ok, _ := binary(i.Int32, i.Valid, j.Int32, j.Valid, sub[int32])
+	return NewInt32(x, ok)
+}
+
+// Mul returns i * j, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (i Int32) Mul(j Int32) Int32 {
+	x, ok, _ := binary(i.Int32, i.Valid, j.Int32, j.Valid, mul[int32])
+	return NewInt32(x, ok)
+}
+
+// Div returns i / j, or null if either is null.
+// It is null as well if j is zero, see DivChecked.
+func (i Int32) Div(j Int32) Int32 {
+	x, ok, _ := binary(i.Int32, i.Valid, j.Int32, j.Valid, div[int32])
+	return NewInt32(x, ok)
+}
+
+// Mod returns i % j, or null if either is null.
+// It is null as well if j is zero, see ModChecked.
+func (i Int32) Mod(j Int32) Int32 {
+	x, ok, _ := binary(i.Int32, i.Valid, j.Int32, j.Valid, mod[int32])
+	return NewInt32(x, ok)
+}
+
+// Neg returns -i, or null if i is null.
+// It wraps around on overflow, see NegChecked.
+func (i Int32) Neg() Int32 {
+	x, ok, _ := unary(i.Int32, i.Valid, neg[int32])
+	return NewInt32(x, ok)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+// The absolute value of the most negative int32 wraps around to itself,
+// see AbsChecked.
+func (i Int32) Abs() Int32 {
+	x, ok, _ := unary(i.Int32, i.Valid, abs[int32])
+	return NewInt32(x, ok)
+}
+
+// AddChecked returns i + j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int32.
+func (i Int32) AddChecked(j Int32) (Int32, error) {
+	x, ok, err := binary(i.Int32, i.Valid, j.Int32, j.Valid, addChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// SubChecked returns i - j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int32.
+func (i Int32) SubChecked(j Int32) (Int32, error) {
+	x, ok, err := binary(i.Int32, i.Valid, j.Int32, j.Valid, subChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// MulChecked returns i * j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int32.
+func (i Int32) MulChecked(j Int32) (Int32, error) {
+	x, ok, err := binary(i.Int32, i.Valid, j.Int32, j.Valid, mulChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// DivChecked returns i / j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero and with ErrOverflow
+// for the most negative int32 divided by -1.
+func (i Int32) DivChecked(j Int32) (Int32, error) {
+	x, ok, err := binary(i.Int32, i.Valid, j.Int32, j.Valid, divChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// ModChecked returns i % j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero.
+func (i Int32) ModChecked(j Int32) (Int32, error) {
+	x, ok, err := binary(i.Int32, i.Valid, j.Int32, j.Valid, modChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// NegChecked returns -i, or null if i is null.
+// It fails with ErrOverflow for the most negative int32.
+func (i Int32) NegChecked() (Int32, error) {
+	x, ok, err := unary(i.Int32, i.Valid, negChecked[int32])
+	return NewInt32(x, ok), err
+}
+
+// AbsChecked returns the absolute value of i, or null if i is null.
+// It fails with ErrOverflow for the most negative int32.
+func (i Int32) AbsChecked() (Int32, error) {
+	x, ok, err := unary(i.Int32, i.Valid, absChecked[int32])
+	return NewInt32(x, ok), err
+}
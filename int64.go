@@ -94,6 +94,23 @@ func (i Int64) Ptr() *int64 {
 	return &i.Int64
 }
 
+// ValueOr returns this Int64's value, or def if this Int64 is null.
+func (i Int64) ValueOr(def int64) int64 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int64
+}
+
+// ValueOrZero returns this Int64's value, or the zero value of int64 if this
+// Int64 is null.
+func (i Int64) ValueOrZero() int64 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int64
+}
+
 // IsZero returns true for invalid Int64's, for future omitempty support (Go 1.4?)
 func (i Int64) IsZero() bool {
 	return !i.Valid
@@ -127,3 +144,103 @@ func (i *Int64) Randomize(nextInt func() int64, fieldType string, shouldBeNull b
 		i.Valid = true
 	}
 }
+
+// Add returns i + j, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (i Int64) Add(j Int64) Int64 {
+	x, ok, _ := binary(i.Int64, i.Valid, j.Int64, j.Valid, add[int64])
+	return NewInt64(x, ok)
+}
+
+// Sub returns i - j, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (i Int64) Sub(j Int64) Int64 {
+	x, ok, _ := binary(i.Int64, i.Valid, j.Int64, j.Valid, sub[int64])
+	return NewInt64(x, ok)
+}
+
+// Mul returns i * j, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (i Int64) Mul(j Int64) Int64 {
+	x, ok, _ := binary(i.Int64, i.Valid, j.Int64, j.Valid, mul[int64])
+	return NewInt64(x, ok)
+}
+
+// Div returns i / j, or null if either is null.
+// It is null as well if j is zero, see DivChecked.
+func (i Int64) Div(j Int64) Int64 {
+	x, ok, _ := binary(i.Int64, i.Valid, j.Int64, j.Valid, div[int64])
+	return NewInt64(x, ok)
+}
+
+// Mod returns i % j, or null if either is null.
+// It is null as well if j is zero, see ModChecked.
+func (i Int64) Mod(j Int64) Int64 {
+	x, ok, _ := binary(i.Int64, i.Valid, j.Int64, j.Valid, mod[int64])
+	return NewInt64(x, ok)
+}
+
+// Neg returns -i, or null if i is null.
+// It wraps around on overflow, see NegChecked.
+func (i Int64) Neg() Int64 {
+	x, ok, _ := unary(i.Int64, i.Valid, neg[int64])
+	return NewInt64(x, ok)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+// The absolute value of the most negative int64 wraps around to itself,
+// see AbsChecked.
+func (i Int64) Abs() Int64 {
+	x, ok, _ := unary(i.Int64, i.Valid, abs[int64])
+	return NewInt64(x, ok)
+}
+
+// AddChecked returns i + j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int64.
+func (i Int64) AddChecked(j Int64) (Int64, error) {
+	x, ok, err := binary(i.Int64, i.Valid, j.Int64, j.Valid, addChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// SubChecked returns i - j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int64.
+func (i Int64) SubChecked(j Int64) (Int64, error) {
+	x, ok, err := binary(i.Int64, i.Valid, j.Int64, j.Valid, subChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// MulChecked returns i * j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int64.
+func (i Int64) MulChecked(j Int64) (Int64, error) {
+	x, ok, err := binary(i.Int64, i.Valid, j.Int64, j.Valid, mulChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// DivChecked returns i / j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero and with ErrOverflow
+// for the most negative int64 divided by -1.
+func (i Int64) DivChecked(j Int64) (Int64, error) {
+	x, ok, err := binary(i.Int64, i.Valid, j.Int64, j.Valid, divChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// ModChecked returns i % j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero.
+func (i Int64) ModChecked(j Int64) (Int64, error) {
+	x, ok, err := binary(i.Int64, i.Valid, j.Int64, j.Valid, modChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// NegChecked returns -i, or null if i is null.
+// It fails with ErrOverflow for the most negative int64.
+func (i Int64) NegChecked() (Int64, error) {
+	x, ok, err := unary(i.Int64, i.Valid, negChecked[int64])
+	return NewInt64(x, ok), err
+}
+
+// AbsChecked returns the absolute value of i, or null if i is null.
+// It fails with ErrOverflow for the most negative int64.
+func (i Int64) AbsChecked() (Int64, error) {
+	x, ok, err := unary(i.Int64, i.Valid, absChecked[int64])
+	return NewInt64(x, ok), err
+}
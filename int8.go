@@ -105,6 +105,23 @@ func (i Int8) Ptr() *int8 {
 	return &i.Int8
 }
 
+// ValueOr returns this Int8's value, or def if this Int8 is null.
+func (i Int8) ValueOr(def int8) int8 {
+	if !i.Valid {
+		return def
+	}
+	return i.Int8
+}
+
+// ValueOrZero returns this Int8's value, or the zero value of int8 if this
+// Int8 is null.
+func (i Int8) ValueOrZero() int8 {
+	if !i.Valid {
+		return 0
+	}
+	return i.Int8
+}
+
 // IsZero returns true for invalid Int8's, for future omitempty support (Go 1.4?)
 func (i Int8) IsZero() bool {
 	return !i.Valid
@@ -138,3 +155,103 @@ func (i *Int8) Randomize(nextInt func() int64, fieldType string, shouldBeNull bo
 		i.Valid = true
 	}
 }
+
+// Add returns i + j, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (i Int8) Add(j Int8) Int8 {
+	x, ok, _ := binary(i.Int8, i.Valid, j.Int8, j.Valid, add[int8])
+	return NewInt8(x, ok)
+}
+
+// Sub returns i - j, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (i Int8) Sub(j Int8) Int8 {
+	x, ok, _ := binary(i.Int8, i.Valid, j.Int8, j.Valid, sub[int8])
+	return NewInt8(x, ok)
+}
+
+// Mul returns i * j, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (i Int8) Mul(j Int8) Int8 {
+	x, ok, _ := binary(i.Int8, i.Valid, j.Int8, j.Valid, mul[int8])
+	return NewInt8(x, ok)
+}
+
+// Div returns i / j, or null if either is null.
+// It is null as well if j is zero, see DivChecked.
+func (i Int8) Div(j Int8) Int8 {
+	x, ok, _ := binary(i.Int8, i.Valid, j.Int8, j.Valid, div[int8])
+	return NewInt8(x, ok)
+}
+
+// Mod returns i % j, or null if either is null.
+// It is null as well if j is zero, see ModChecked.
+func (i Int8) Mod(j Int8) Int8 {
+	x, ok, _ := binary(i.Int8, i.Valid, j.Int8, j.Valid, mod[int8])
+	return NewInt8(x, ok)
+}
+
+// Neg returns -i, or null if i is null.
+// It wraps around on overflow, see NegChecked.
+func (i Int8) Neg() Int8 {
+	x, ok, _ := unary(i.Int8, i.Valid, neg[int8])
+	return NewInt8(x, ok)
+}
+
+// Abs returns the absolute value of i, or null if i is null.
+// The absolute value of the most negative int8 wraps around to itself,
+// see AbsChecked.
+func (i Int8) Abs() Int8 {
+	x, ok, _ := unary(i.Int8, i.Valid, abs[int8])
+	return NewInt8(x, ok)
+}
+
+// AddChecked returns i + j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int8.
+func (i Int8) AddChecked(j Int8) (Int8, error) {
+	x, ok, err := binary(i.Int8, i.Valid, j.Int8, j.Valid, addChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// SubChecked returns i - j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int8.
+func (i Int8) SubChecked(j Int8) (Int8, error) {
+	x, ok, err := binary(i.Int8, i.Valid, j.Int8, j.Valid, subChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// MulChecked returns i * j, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an int8.
+func (i Int8) MulChecked(j Int8) (Int8, error) {
+	x, ok, err := binary(i.Int8, i.Valid, j.Int8, j.Valid, mulChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// DivChecked returns i / j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero and with ErrOverflow
+// for the most negative int8 divided by -1.
+func (i Int8) DivChecked(j Int8) (Int8, error) {
+	x, ok, err := binary(i.Int8, i.Valid, j.Int8, j.Valid, divChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// ModChecked returns i % j, or null if either is null.
+// It fails with ErrDivisionByZero if j is zero.
+func (i Int8) ModChecked(j Int8) (Int8, error) {
+	x, ok, err := binary(i.Int8, i.Valid, j.Int8, j.Valid, modChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// NegChecked returns -i, or null if i is null.
+// It fails with ErrOverflow for the most negative int8.
+func (i Int8) NegChecked() (Int8, error) {
+	x, ok, err := unary(i.Int8, i.Valid, negChecked[int8])
+	return NewInt8(x, ok), err
+}
+
+// AbsChecked returns the absolute value of i, or null if i is null.
+// It fails with ErrOverflow for the most negative int8.
+func (i Int8) AbsChecked() (Int8, error) {
+	x, ok, err := unary(i.Int8, i.Valid, absChecked[int8])
+	return NewInt8(x, ok), err
+}
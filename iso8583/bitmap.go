@@ -0,0 +1,74 @@
+package iso8583
+
+// Bitmap marks the data elements 2 to 128 present in a message.
+// Bit 1, which announces the secondary bitmap, is managed by Bytes and
+// ParseBitmap.
+type Bitmap [16]byte
+
+// Set marks field as present. It panics if field is not in 2..128.
+func (b *Bitmap) Set(field int) {
+	checkBit(field)
+	b[(field-1)/8] |= 0x80 >> uint((field-1)%8)
+}
+
+// Clear marks field as absent. It panics if field is not in 2..128.
+func (b *Bitmap) Clear(field int) {
+	checkBit(field)
+	b[(field-1)/8] &^= 0x80 >> uint((field-1)%8)
+}
+
+// IsSet reports whether field is present.
+func (b Bitmap) IsSet(field int) bool {
+	if field < 1 || field > 128 {
+		return false
+	}
+	return b[(field-1)/8]&(0x80>>uint((field-1)%8)) != 0
+}
+
+// Fields returns the present fields in increasing order, without bit 1.
+func (b Bitmap) Fields() []int {
+	var fields []int
+	for field := 2; field <= 128; field++ {
+		if b.IsSet(field) {
+			fields = append(fields, field)
+		}
+	}
+	return fields
+}
+
+// Bytes returns the primary bitmap, followed by the secondary bitmap if
+// any of the fields 65 to 128 is present, with bit 1 set accordingly.
+func (b Bitmap) Bytes() []byte {
+	b[0] &^= 0x80
+	for _, c := range b[8:] {
+		if c != 0 {
+			b[0] |= 0x80
+			return b[:]
+		}
+	}
+	return append([]byte{}, b[:8]...)
+}
+
+// ParseBitmap reads the primary bitmap at the start of data and the
+// secondary bitmap if bit 1 is set, and returns the number of bytes read.
+func ParseBitmap(data []byte) (b Bitmap, n int, err error) {
+	if len(data) < 8 {
+		return b, 0, ErrShortMessage
+	}
+	n = 8
+	if data[0]&0x80 != 0 {
+		n = 16
+		if len(data) < n {
+			return b, 0, ErrShortMessage
+		}
+	}
+	copy(b[:], data[:n])
+	b[0] &^= 0x80
+	return b, n, nil
+}
+
+func checkBit(field int) {
+	if field < 2 || field > 128 {
+		panic("iso8583: bitmap field out of range 2..128")
+	}
+}
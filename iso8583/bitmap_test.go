@@ -0,0 +1,60 @@
+package iso8583
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestBitmap(t *testing.T) {
+	var b Bitmap
+	for _, field := range []int{2, 3, 4, 64} {
+		b.Set(field)
+	}
+	want := []byte{0x70, 0, 0, 0, 0, 0, 0, 0x01}
+	if got := b.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("Bytes() got = % x, want % x", got, want)
+	}
+
+	b.Set(128)
+	want = []byte{0xf0, 0, 0, 0, 0, 0, 0, 0x01, 0, 0, 0, 0, 0, 0, 0, 0x01}
+	if got := b.Bytes(); !bytes.Equal(got, want) {
+		t.Errorf("Bytes() with secondary got = % x, want % x", got, want)
+	}
+	if got := b.Fields(); !reflect.DeepEqual(got, []int{2, 3, 4, 64, 128}) {
+		t.Errorf("Fields() got = %v", got)
+	}
+
+	parsed, n, err := ParseBitmap(append(want, 0xee))
+	if err != nil || n != 16 || parsed != b {
+		t.Errorf("ParseBitmap() got = %v, %d, %v, want %v", parsed, n, err, b)
+	}
+
+	b.Clear(128)
+	if b.IsSet(128) || len(b.Bytes()) != 8 {
+		t.Errorf("Clear() should drop the secondary bitmap")
+	}
+	if b.IsSet(0) || b.IsSet(129) {
+		t.Errorf("IsSet() out of range should be false")
+	}
+}
+
+func TestParseBitmapShort(t *testing.T) {
+	if _, _, err := ParseBitmap([]byte{0x70}); !errors.Is(err, ErrShortMessage) {
+		t.Errorf("ParseBitmap() error = %v, want %v", err, ErrShortMessage)
+	}
+	if _, _, err := ParseBitmap([]byte{0x80, 0, 0, 0, 0, 0, 0, 0, 0}); !errors.Is(err, ErrShortMessage) {
+		t.Errorf("ParseBitmap() of secondary error = %v, want %v", err, ErrShortMessage)
+	}
+}
+
+func TestBitmapSetPanics(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Error("Set(1) should panic")
+		}
+	}()
+	var b Bitmap
+	b.Set(1)
+}
@@ -0,0 +1,303 @@
+// Package iso8583 packs and unpacks ISO 8583 messages described by a Spec,
+// and decodes their data elements directly into the nullable types of
+// package null. A data element whose bit is not set in the bitmap decodes
+// to a null value, a null value is packed by leaving its bit unset.
+package iso8583
+
+import (
+	"errors"
+	"strconv"
+)
+
+// vars
+var (
+	ErrUnknownField    = errors.New("field is not in the spec")
+	ErrInvalidSpec     = errors.New("invalid field spec")
+	ErrFieldLength     = errors.New("invalid field length")
+	ErrFieldCharacter  = errors.New("invalid character for the field type")
+	ErrShortMessage    = errors.New("message is too short")
+	ErrTrailingData    = errors.New("trailing data after the last field")
+	ErrUnsupportedType = errors.New("unsupported Go type for the field")
+)
+
+// Type is the ISO 8583 content type of a data element.
+type Type int
+
+// Types of data elements.
+const (
+	// TypeN is numeric: digits only.
+	TypeN Type = iota
+	// TypeAN is alphanumeric: letters, digits and spaces.
+	TypeAN
+	// TypeANS is alphanumeric and special: printable ASCII characters.
+	TypeANS
+	// TypeB is binary.
+	TypeB
+)
+
+// String returns the ISO 8583 abbreviation of t.
+func (t Type) String() string {
+	switch t {
+	case TypeN:
+		return "n"
+	case TypeAN:
+		return "an"
+	case TypeANS:
+		return "ans"
+	case TypeB:
+		return "b"
+	}
+	return "Type(" + strconv.Itoa(int(t)) + ")"
+}
+
+// Length tells whether a data element has a fixed length or a length prefix.
+type Length int
+
+// Lengths of data elements.
+const (
+	// Fixed elements always have their Max length.
+	Fixed Length = iota
+	// LLVAR elements are preceded by a two digit length.
+	LLVAR
+	// LLLVAR elements are preceded by a three digit length.
+	LLLVAR
+)
+
+// Encoding is the byte encoding of characters and digits.
+type Encoding int
+
+// Encodings of data elements, length prefixes and MTIs.
+const (
+	// ASCII writes one ASCII byte per character.
+	ASCII Encoding = iota
+	// BCD packs two digits per byte, left padded with a zero digit.
+	// It is valid for TypeN data, length prefixes and MTIs only.
+	BCD
+	// EBCDIC writes one EBCDIC (code page 037) byte per character.
+	EBCDIC
+)
+
+// Field is the spec of a data element.
+type Field struct {
+	Description string
+	Type        Type
+	Length      Length
+	// Max is the length of a Fixed element, or the maximum length of a
+	// variable one: digits for TypeN, characters for TypeAN and TypeANS
+	// and bytes for TypeB.
+	Max int
+	// Encoding of the content. It is ignored for TypeB.
+	Encoding Encoding
+	// LengthEncoding of the LLVAR and LLLVAR prefix.
+	LengthEncoding Encoding
+}
+
+// validate checks that the spec itself is consistent.
+func (f Field) validate() error {
+	switch {
+	case f.Max <= 0,
+		f.Length == LLVAR && f.Max > 99,
+		f.Length == LLLVAR && f.Max > 999,
+		f.Length < Fixed || f.Length > LLLVAR,
+		f.Type < TypeN || f.Type > TypeB,
+		f.Encoding == BCD && f.Type != TypeN && f.Type != TypeB:
+		return ErrInvalidSpec
+	}
+	return nil
+}
+
+// Pack encodes value as the element described by f. value holds ASCII
+// characters for TypeN, TypeAN and TypeANS, and raw bytes for TypeB.
+// Short Fixed values are padded with leading zeros for TypeN and trailing
+// spaces for TypeAN and TypeANS.
+func (f Field) Pack(value []byte) ([]byte, error) {
+	if err := f.validate(); err != nil {
+		return nil, err
+	}
+	if len(value) > f.Max {
+		return nil, ErrFieldLength
+	}
+	if !f.valid(value) {
+		return nil, ErrFieldCharacter
+	}
+	if f.Length == Fixed && len(value) < f.Max {
+		switch f.Type {
+		case TypeB:
+			return nil, ErrFieldLength
+		case TypeN:
+			value = append(zeros(f.Max-len(value)), value...)
+		default:
+			value = append(append([]byte{}, value...), spaces(f.Max-len(value))...)
+		}
+	}
+
+	var out []byte
+	if f.Length != Fixed {
+		out = encodeDigits(f.LengthEncoding, f.prefixDigits(), len(value))
+	}
+	if f.Type == TypeB {
+		return append(out, value...), nil
+	}
+	return append(out, encode(f.Encoding, value)...), nil
+}
+
+// Unpack decodes the element described by f at the start of data and
+// returns its value and the number of bytes read. The value is in the
+// form taken by Pack, including the padding of Fixed elements.
+func (f Field) Unpack(data []byte) (value []byte, n int, err error) {
+	if err := f.validate(); err != nil {
+		return nil, 0, err
+	}
+	length := f.Max
+	if f.Length != Fixed {
+		var read int
+		length, read, err = decodeDigits(f.LengthEncoding, f.prefixDigits(), data)
+		if err != nil {
+			return nil, 0, err
+		}
+		if length > f.Max {
+			return nil, 0, ErrFieldLength
+		}
+		n, data = read, data[read:]
+	}
+
+	size := length
+	if f.Type != TypeB && f.Encoding == BCD {
+		size = (length + 1) / 2
+	}
+	if len(data) < size {
+		return nil, 0, ErrShortMessage
+	}
+	if f.Type == TypeB {
+		value = append([]byte{}, data[:size]...)
+	} else if value, err = decode(f.Encoding, data[:size], length); err != nil {
+		return nil, 0, err
+	}
+	if !f.valid(value) {
+		return nil, 0, ErrFieldCharacter
+	}
+	return value, n + size, nil
+}
+
+func (f Field) prefixDigits() int {
+	if f.Length == LLLVAR {
+		return 3
+	}
+	return 2
+}
+
+// valid reports whether every character of value is allowed by the type of f.
+func (f Field) valid(value []byte) bool {
+	for _, c := range value {
+		var ok bool
+		switch f.Type {
+		case TypeN:
+			ok = isDigit(c)
+		case TypeAN:
+			ok = isDigit(c) || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c == ' '
+		case TypeANS:
+			ok = c >= ' ' && c <= '~'
+		default:
+			ok = true
+		}
+		if !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// encode converts ASCII characters to e. value holds digits only for BCD.
+func encode(e Encoding, value []byte) []byte {
+	switch e {
+	case BCD:
+		out := make([]byte, (len(value)+1)/2)
+		odd := len(value) % 2
+		for i, c := range value {
+			j := i + odd
+			out[j/2] |= (c - '0') << (4 * uint(1-j%2))
+		}
+		return out
+	case EBCDIC:
+		out := make([]byte, len(value))
+		for i, c := range value {
+			out[i] = asciiToEBCDIC[c]
+		}
+		return out
+	}
+	return append([]byte{}, value...)
+}
+
+// decode converts data in e to ASCII characters. length is the number of
+// digits of BCD data.
+func decode(e Encoding, data []byte, length int) ([]byte, error) {
+	switch e {
+	case BCD:
+		out := make([]byte, 0, length)
+		for i := len(data)*2 - length; i < len(data)*2; i++ {
+			d := data[i/2] >> (4 * uint(1-i%2)) & 0x0f
+			if d > 9 {
+				return nil, ErrFieldCharacter
+			}
+			out = append(out, '0'+d)
+		}
+		return out, nil
+	case EBCDIC:
+		out := make([]byte, len(data))
+		for i, c := range data {
+			if out[i] = ebcdicToASCII[c]; out[i] == 0 {
+				return nil, ErrFieldCharacter
+			}
+		}
+		return out, nil
+	}
+	return append([]byte{}, data...), nil
+}
+
+// encodeDigits writes n with the given number of digits.
+func encodeDigits(e Encoding, digits, n int) []byte {
+	s := strconv.Itoa(n)
+	return encode(e, append(zeros(digits-len(s)), s...))
+}
+
+// decodeDigits reads a number of the given number of digits.
+func decodeDigits(e Encoding, digits int, data []byte) (n, read int, err error) {
+	read = digits
+	if e == BCD {
+		read = (digits + 1) / 2
+	}
+	if len(data) < read {
+		return 0, 0, ErrShortMessage
+	}
+	s, err := decode(e, data[:read], digits)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, c := range s {
+		if !isDigit(c) {
+			return 0, 0, ErrFieldLength
+		}
+		n = n*10 + int(c-'0')
+	}
+	return n, read, nil
+}
+
+func zeros(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = '0'
+	}
+	return b
+}
+
+func spaces(n int) []byte {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = ' '
+	}
+	return b
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
@@ -0,0 +1,111 @@
+package iso8583
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+func TestFieldPackUnpack(t *testing.T) {
+	tests := []struct {
+		name   string
+		field  Field
+		value  string
+		packed []byte
+		// unpacked is the value after a round trip, if different.
+		unpacked string
+	}{
+		{"n fixed ascii", Field{Type: TypeN, Max: 6}, "123", []byte("000123"), "000123"},
+		{"n fixed bcd", Field{Type: TypeN, Max: 6, Encoding: BCD}, "123456", []byte{0x12, 0x34, 0x56}, ""},
+		{"n fixed bcd odd", Field{Type: TypeN, Max: 3, Encoding: BCD}, "123", []byte{0x01, 0x23}, ""},
+		{"n llvar ascii", Field{Type: TypeN, Length: LLVAR, Max: 19}, "4111111111111111",
+			[]byte("164111111111111111"), ""},
+		{"n llvar bcd", Field{Type: TypeN, Length: LLVAR, Max: 19, Encoding: BCD, LengthEncoding: BCD},
+			"12345", []byte{0x05, 0x01, 0x23, 0x45}, ""},
+		{"ans lllvar bcd prefix", Field{Type: TypeANS, Length: LLLVAR, Max: 999, LengthEncoding: BCD},
+			"a b", []byte{0x00, 0x03, 'a', ' ', 'b'}, ""},
+		{"an fixed padded", Field{Type: TypeAN, Max: 5}, "AB1", []byte("AB1  "), "AB1  "},
+		{"ans ebcdic", Field{Type: TypeANS, Length: LLVAR, Max: 20, Encoding: EBCDIC, LengthEncoding: EBCDIC},
+			"Ab1/", []byte{0xF0, 0xF4, 0xC1, 0x82, 0xF1, 0x61}, ""},
+		{"b fixed", Field{Type: TypeB, Max: 2}, "\x00\xff", []byte{0x00, 0xff}, ""},
+		{"b llvar", Field{Type: TypeB, Length: LLVAR, Max: 8}, "\x01", []byte{'0', '1', 0x01}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packed, err := tt.field.Pack([]byte(tt.value))
+			if err != nil {
+				t.Fatalf("Pack() error = %v", err)
+			}
+			if !bytes.Equal(packed, tt.packed) {
+				t.Errorf("Pack() got = % x, want % x", packed, tt.packed)
+			}
+
+			value, n, err := tt.field.Unpack(append(packed, 0xee))
+			if err != nil {
+				t.Fatalf("Unpack() error = %v", err)
+			}
+			want := tt.unpacked
+			if want == "" {
+				want = tt.value
+			}
+			if string(value) != want || n != len(packed) {
+				t.Errorf("Unpack() got = %q, %d, want %q, %d", value, n, want, len(packed))
+			}
+		})
+	}
+}
+
+func TestFieldErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		field Field
+		value string
+		want  error
+	}{
+		{"too long", Field{Type: TypeN, Max: 2}, "123", ErrFieldLength},
+		{"not numeric", Field{Type: TypeN, Max: 3}, "12a", ErrFieldCharacter},
+		{"not alphanumeric", Field{Type: TypeAN, Max: 3}, "a-b", ErrFieldCharacter},
+		{"not printable", Field{Type: TypeANS, Max: 3}, "a\nb", ErrFieldCharacter},
+		{"short binary", Field{Type: TypeB, Max: 2}, "\x01", ErrFieldLength},
+		{"bcd text", Field{Type: TypeAN, Max: 2, Encoding: BCD}, "ab", ErrInvalidSpec},
+		{"llvar too large", Field{Type: TypeAN, Length: LLVAR, Max: 100}, "ab", ErrInvalidSpec},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.field.Pack([]byte(tt.value)); !errors.Is(err, tt.want) {
+				t.Errorf("Pack() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	unpackTests := []struct {
+		name  string
+		field Field
+		data  []byte
+		want  error
+	}{
+		{"short", Field{Type: TypeN, Max: 6}, []byte("123"), ErrShortMessage},
+		{"short prefix", Field{Type: TypeN, Length: LLLVAR, Max: 999}, []byte("12"), ErrShortMessage},
+		{"length over max", Field{Type: TypeN, Length: LLVAR, Max: 4}, []byte("0512345"), ErrFieldLength},
+		{"bad prefix", Field{Type: TypeN, Length: LLVAR, Max: 4}, []byte("x1"), ErrFieldLength},
+		{"bad bcd digit", Field{Type: TypeN, Max: 2, Encoding: BCD}, []byte{0x1a}, ErrFieldCharacter},
+		{"bad ebcdic", Field{Type: TypeANS, Max: 1, Encoding: EBCDIC}, []byte{0x00}, ErrFieldCharacter},
+		{"not numeric", Field{Type: TypeN, Max: 2}, []byte("1a"), ErrFieldCharacter},
+	}
+	for _, tt := range unpackTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, _, err := tt.field.Unpack(tt.data); !errors.Is(err, tt.want) {
+				t.Errorf("Unpack() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestEBCDICTable(t *testing.T) {
+	for c := byte(' '); c <= '~'; c++ {
+		e := asciiToEBCDIC[c]
+		if e == 0 || ebcdicToASCII[e] != c {
+			t.Errorf("%q does not round trip through EBCDIC: %#x", c, e)
+		}
+	}
+}
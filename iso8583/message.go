@@ -0,0 +1,180 @@
+package iso8583
+
+import (
+	"encoding/hex"
+	"strconv"
+)
+
+// Spec describes the messages of an ISO 8583 dialect.
+type Spec struct {
+	// MTIEncoding is the encoding of the four digit message type indicator.
+	MTIEncoding Encoding
+	// HexBitmap writes the bitmaps as 16 hexadecimal characters each,
+	// in MTIEncoding, instead of 8 binary bytes.
+	HexBitmap bool
+	// Fields are the data elements 2 to 128 by number.
+	Fields map[int]Field
+}
+
+// Message is an ISO 8583 message with the values of its present elements,
+// in the form taken by Field.Pack.
+type Message struct {
+	MTI    string
+	Fields map[int][]byte
+}
+
+// FieldError reports the element of a message that could not be packed,
+// unpacked or converted. Field is 0 for the MTI, 1 for the bitmap and -1
+// for the message as a whole.
+type FieldError struct {
+	Field int
+	// Offset is the byte offset of the element in the packed message,
+	// or -1 when packing or converting.
+	Offset int
+	Err    error
+}
+
+// Error implements error.
+func (e *FieldError) Error() string {
+	var name string
+	switch e.Field {
+	case -1:
+		name = "message"
+	case 0:
+		name = "MTI"
+	case 1:
+		name = "bitmap"
+	default:
+		name = "field " + strconv.Itoa(e.Field)
+	}
+	if e.Offset >= 0 {
+		name += " at offset " + strconv.Itoa(e.Offset)
+	}
+	return "iso8583: " + name + ": " + e.Err.Error()
+}
+
+// Unwrap returns the underlying cause.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// mtiField describes the message type indicator.
+var mtiField = Field{Type: TypeN, Max: 4}
+
+// Pack encodes m. Errors are of type *FieldError.
+func (s *Spec) Pack(m *Message) ([]byte, error) {
+	if len(m.MTI) != mtiField.Max {
+		return nil, &FieldError{Field: 0, Offset: -1, Err: ErrFieldLength}
+	}
+	mti := mtiField
+	mti.Encoding = s.MTIEncoding
+	out, err := mti.Pack([]byte(m.MTI))
+	if err != nil {
+		return nil, &FieldError{Field: 0, Offset: -1, Err: err}
+	}
+
+	var bitmap Bitmap
+	for field := range m.Fields {
+		if field < 2 || field > 128 {
+			return nil, &FieldError{Field: field, Offset: -1, Err: ErrUnknownField}
+		}
+		bitmap.Set(field)
+	}
+	out = append(out, s.packBitmap(bitmap)...)
+
+	for _, field := range bitmap.Fields() {
+		f, ok := s.Fields[field]
+		if !ok {
+			return nil, &FieldError{Field: field, Offset: -1, Err: ErrUnknownField}
+		}
+		data, err := f.Pack(m.Fields[field])
+		if err != nil {
+			return nil, &FieldError{Field: field, Offset: -1, Err: err}
+		}
+		out = append(out, data...)
+	}
+	return out, nil
+}
+
+// Unpack decodes a message. Errors are of type *FieldError.
+func (s *Spec) Unpack(data []byte) (*Message, error) {
+	mti := mtiField
+	mti.Encoding = s.MTIEncoding
+	value, offset, err := mti.Unpack(data)
+	if err != nil {
+		return nil, &FieldError{Field: 0, Offset: 0, Err: err}
+	}
+	m := &Message{MTI: string(value), Fields: map[int][]byte{}}
+
+	bitmap, n, err := s.unpackBitmap(data[offset:])
+	if err != nil {
+		return nil, &FieldError{Field: 1, Offset: offset, Err: err}
+	}
+	offset += n
+
+	for _, field := range bitmap.Fields() {
+		f, ok := s.Fields[field]
+		if !ok {
+			return nil, &FieldError{Field: field, Offset: offset, Err: ErrUnknownField}
+		}
+		value, n, err := f.Unpack(data[offset:])
+		if err != nil {
+			return nil, &FieldError{Field: field, Offset: offset, Err: err}
+		}
+		m.Fields[field] = value
+		offset += n
+	}
+	if offset != len(data) {
+		return nil, &FieldError{Field: -1, Offset: offset, Err: ErrTrailingData}
+	}
+	return m, nil
+}
+
+func (s *Spec) packBitmap(b Bitmap) []byte {
+	data := b.Bytes()
+	if !s.HexBitmap {
+		return data
+	}
+	return encode(s.bitmapEncoding(), []byte(hexUpper(data)))
+}
+
+func (s *Spec) unpackBitmap(data []byte) (Bitmap, int, error) {
+	if !s.HexBitmap {
+		return ParseBitmap(data)
+	}
+	e := s.bitmapEncoding()
+	var raw []byte
+	for n := 16; ; n = 32 {
+		if len(data) < n {
+			return Bitmap{}, 0, ErrShortMessage
+		}
+		text, err := decode(e, data[:n], n)
+		if err != nil {
+			return Bitmap{}, 0, err
+		}
+		if raw, err = hex.DecodeString(string(text)); err != nil {
+			return Bitmap{}, 0, ErrFieldCharacter
+		}
+		if raw[0]&0x80 == 0 || n == 32 {
+			b, _, err := ParseBitmap(raw)
+			return b, n, err
+		}
+	}
+}
+
+// bitmapEncoding is the character encoding of hexadecimal bitmaps.
+func (s *Spec) bitmapEncoding() Encoding {
+	if s.MTIEncoding == EBCDIC {
+		return EBCDIC
+	}
+	return ASCII
+}
+
+func hexUpper(data []byte) string {
+	const digits = "0123456789ABCDEF"
+	out := make([]byte, 0, 2*len(data))
+	for _, c := range data {
+		out = append(out, digits[c>>4], digits[c&0x0f])
+	}
+	return string(out)
+}
@@ -0,0 +1,126 @@
+package iso8583
+
+import (
+	"bytes"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// testSpec is a small ASCII dialect.
+var testSpec = &Spec{
+	Fields: map[int]Field{
+		2:   {Description: "Primary account number", Type: TypeN, Length: LLVAR, Max: 19},
+		3:   {Description: "Processing code", Type: TypeN, Max: 6},
+		4:   {Description: "Amount, transaction", Type: TypeN, Max: 12},
+		14:  {Description: "Date, expiration", Type: TypeN, Max: 4},
+		37:  {Description: "Retrieval reference number", Type: TypeAN, Max: 12},
+		41:  {Description: "Card acceptor terminal identification", Type: TypeANS, Max: 8},
+		52:  {Description: "Personal identification number data", Type: TypeB, Max: 8},
+		70:  {Description: "Network management information code", Type: TypeN, Max: 3},
+		102: {Description: "Account identification 1", Type: TypeANS, Length: LLVAR, Max: 28},
+	},
+}
+
+func TestSpecPackUnpack(t *testing.T) {
+	m := &Message{
+		MTI: "0200",
+		Fields: map[int][]byte{
+			3:  []byte("000000"),
+			4:  []byte("000000001000"),
+			41: []byte("TERM0001"),
+		},
+	}
+	data, err := testSpec.Pack(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := append([]byte("0200"), 0x30, 0, 0, 0, 0, 0x80, 0, 0)
+	want = append(want, "000000000000001000TERM0001"...)
+	if !bytes.Equal(data, want) {
+		t.Errorf("Pack() got = % x, want % x", data, want)
+	}
+
+	got, err := testSpec.Unpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, m) {
+		t.Errorf("Unpack() got = %+v, want %+v", got, m)
+	}
+}
+
+func TestSpecSecondaryBitmap(t *testing.T) {
+	for _, spec := range []*Spec{
+		testSpec,
+		{HexBitmap: true, Fields: testSpec.Fields},
+		{HexBitmap: true, MTIEncoding: EBCDIC, Fields: testSpec.Fields},
+		{MTIEncoding: BCD, Fields: testSpec.Fields},
+	} {
+		m := &Message{MTI: "0800", Fields: map[int][]byte{70: []byte("301"), 102: []byte("ACC 1")}}
+		data, err := spec.Pack(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := spec.Unpack(data)
+		if err != nil {
+			t.Fatalf("Unpack(% x) error = %v", data, err)
+		}
+		if !reflect.DeepEqual(got, m) {
+			t.Errorf("Unpack() got = %+v, want %+v", got, m)
+		}
+	}
+
+	hex := &Spec{HexBitmap: true, Fields: testSpec.Fields}
+	data, _ := hex.Pack(&Message{MTI: "0800", Fields: map[int][]byte{70: []byte("301")}})
+	if want := "08008000000000000000" + "0400000000000000" + "301"; string(data) != want {
+		t.Errorf("Pack() with hex bitmap got = %q, want %q", data, want)
+	}
+}
+
+func TestSpecErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		m     *Message
+		field int
+		want  error
+	}{
+		{"short MTI", &Message{MTI: "020"}, 0, ErrFieldLength},
+		{"unknown field", &Message{MTI: "0200", Fields: map[int][]byte{5: []byte("1")}}, 5, ErrUnknownField},
+		{"out of range", &Message{MTI: "0200", Fields: map[int][]byte{1: nil}}, 1, ErrUnknownField},
+		{"bad value", &Message{MTI: "0200", Fields: map[int][]byte{3: []byte("abc")}}, 3, ErrFieldCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testSpec.Pack(tt.m)
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tt.field || !errors.Is(err, tt.want) {
+				t.Errorf("Pack() error = %v, want %v of field %d", err, tt.want, tt.field)
+			}
+		})
+	}
+
+	data, _ := testSpec.Pack(&Message{MTI: "0200", Fields: map[int][]byte{3: []byte("000000")}})
+	unpackTests := []struct {
+		name   string
+		data   []byte
+		field  int
+		offset int
+		want   error
+	}{
+		{"short MTI", data[:2], 0, 0, ErrShortMessage},
+		{"short bitmap", data[:6], 1, 4, ErrShortMessage},
+		{"short field", data[:len(data)-1], 3, 12, ErrShortMessage},
+		{"trailing data", append(data, '0'), -1, len(data), ErrTrailingData},
+	}
+	for _, tt := range unpackTests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := testSpec.Unpack(tt.data)
+			var fieldErr *FieldError
+			if !errors.As(err, &fieldErr) || fieldErr.Field != tt.field || fieldErr.Offset != tt.offset ||
+				!errors.Is(err, tt.want) {
+				t.Errorf("Unpack() error = %v, want %v of field %d at %d", err, tt.want, tt.field, tt.offset)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+package iso8583
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/metricsglobal/null"
+)
+
+// Marshal packs the struct v points to. Its fields tagged iso8583:"N" hold
+// data element N and are one of null.String, null.Secret, null.Int64,
+// null.Int, null.Bytes, null.CardDate and null.PAN; a null value leaves the
+// element out. The field tagged iso8583:"mti" is a string or a null.String.
+// A null.CardDate is written as YYMM, or as packed BCD into a TypeB element.
+func (s *Spec) Marshal(v interface{}) ([]byte, error) {
+	m, err := s.Encode(v)
+	if err != nil {
+		return nil, err
+	}
+	return s.Pack(m)
+}
+
+// Unmarshal unpacks data into the struct v points to, see Marshal.
+// Elements absent from data are stored as null values.
+func (s *Spec) Unmarshal(data []byte, v interface{}) error {
+	m, err := s.Unpack(data)
+	if err != nil {
+		return err
+	}
+	return s.Decode(m, v)
+}
+
+// Encode converts the struct v points to into a Message, see Marshal.
+func (s *Spec) Encode(v interface{}) (*Message, error) {
+	m := &Message{Fields: map[int][]byte{}}
+	err := walkTagged(v, func(field int, p interface{}) error {
+		if field == 0 {
+			return encodeMTI(m, p)
+		}
+		f, ok := s.Fields[field]
+		if !ok {
+			return ErrUnknownField
+		}
+		value, valid, err := encodeValue(f, p)
+		if valid {
+			m.Fields[field] = value
+		}
+		return err
+	})
+	return m, err
+}
+
+// Decode stores the elements of m into the struct v points to, see Marshal.
+func (s *Spec) Decode(m *Message, v interface{}) error {
+	return walkTagged(v, func(field int, p interface{}) error {
+		if field == 0 {
+			return decodeMTI(m, p)
+		}
+		f, ok := s.Fields[field]
+		if !ok {
+			return ErrUnknownField
+		}
+		value, present := m.Fields[field]
+		if !present {
+			// Report an unsupported type even if the element is absent.
+			if _, _, err := encodeValue(f, p); err != nil {
+				return err
+			}
+			reflect.ValueOf(p).Elem().Set(reflect.Zero(reflect.TypeOf(p).Elem()))
+			return nil
+		}
+		return decodeValue(f, value, p)
+	})
+}
+
+// walkTagged calls fn with the number and the address of every tagged
+// field of the struct v points to. The MTI has the number 0.
+func walkTagged(v interface{}, fn func(field int, p interface{}) error) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("iso8583: expected a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+	for i := 0; i < rv.NumField(); i++ {
+		tag, ok := rv.Type().Field(i).Tag.Lookup("iso8583")
+		if !ok || tag == "-" {
+			continue
+		}
+		field := 0
+		if tag != "mti" {
+			n, err := strconv.Atoi(tag)
+			if err != nil || n < 2 || n > 128 {
+				return errors.New("iso8583: invalid tag " + strconv.Quote(tag) + " of " + rv.Type().Field(i).Name)
+			}
+			field = n
+		}
+		if err := fn(field, rv.Field(i).Addr().Interface()); err != nil {
+			return &FieldError{Field: field, Offset: -1, Err: err}
+		}
+	}
+	return nil
+}
+
+func encodeMTI(m *Message, p interface{}) error {
+	switch x := p.(type) {
+	case *string:
+		m.MTI = *x
+	case *null.String:
+		m.MTI = x.String
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+func decodeMTI(m *Message, p interface{}) error {
+	switch x := p.(type) {
+	case *string:
+		*x = m.MTI
+	case *null.String:
+		*x = null.StringFrom(m.MTI)
+	default:
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+// encodeValue returns the value of the element f held by p, and whether it
+// is not null.
+func encodeValue(f Field, p interface{}) ([]byte, bool, error) {
+	switch x := p.(type) {
+	case *null.String:
+		return []byte(x.String), x.Valid, textOnly(f)
+	case *null.Secret:
+		return []byte(x.Reveal()), x.Valid, textOnly(f)
+	case *null.Bytes:
+		return x.Bytes, x.Valid, nil
+	case *null.Int64:
+		return []byte(strconv.FormatInt(x.Int64, 10)), x.Valid, numericOnly(f)
+	case *null.Int:
+		return []byte(strconv.Itoa(x.Int)), x.Valid, numericOnly(f)
+	case *null.PAN:
+		return []byte(x.Reveal()), x.Valid, numericOnly(f)
+	case *null.CardDate:
+		if f.Type == TypeB {
+			return x.BCDYYMM(), x.Valid, nil
+		}
+		return []byte(x.YYMM()), x.Valid, numericOnly(f)
+	}
+	return nil, false, ErrUnsupportedType
+}
+
+// decodeValue stores value, the content of the element f, in p.
+// The padding spaces of Fixed text elements are removed.
+func decodeValue(f Field, value []byte, p interface{}) error {
+	if f.Length == Fixed && (f.Type == TypeAN || f.Type == TypeANS) {
+		value = bytes.TrimRight(value, " ")
+	}
+	var err error
+	switch x := p.(type) {
+	case *null.String:
+		if err = textOnly(f); err == nil {
+			*x = null.StringFrom(string(value))
+		}
+	case *null.Secret:
+		if err = textOnly(f); err == nil {
+			*x = null.SecretFrom(string(value))
+		}
+	case *null.Bytes:
+		*x = null.BytesFrom(value)
+	case *null.Int64:
+		if err = numericOnly(f); err == nil {
+			var n int64
+			if n, err = strconv.ParseInt(string(value), 10, 64); err == nil {
+				*x = null.Int64From(n)
+			}
+		}
+	case *null.Int:
+		if err = numericOnly(f); err == nil {
+			var n int
+			if n, err = strconv.Atoi(string(value)); err == nil {
+				*x = null.IntFrom(n)
+			}
+		}
+	case *null.PAN:
+		if err = numericOnly(f); err == nil {
+			*x, err = null.PANFromString(string(value))
+		}
+	case *null.CardDate:
+		if f.Type == TypeB {
+			*x, err = null.CardDateFromBCDYYMM(value)
+		} else if err = numericOnly(f); err == nil {
+			*x, err = null.CardDateFromYYMM(string(value))
+		}
+	default:
+		err = ErrUnsupportedType
+	}
+	return err
+}
+
+func textOnly(f Field) error {
+	if f.Type == TypeB {
+		return ErrUnsupportedType
+	}
+	return nil
+}
+
+func numericOnly(f Field) error {
+	if f.Type != TypeN {
+		return ErrUnsupportedType
+	}
+	return nil
+}
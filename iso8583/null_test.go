@@ -0,0 +1,135 @@
+package iso8583
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/metricsglobal/null"
+)
+
+type authorization struct {
+	MTI            string        `iso8583:"mti"`
+	PAN            null.PAN      `iso8583:"2"`
+	ProcessingCode null.String   `iso8583:"3"`
+	Amount         null.Int64    `iso8583:"4"`
+	Expiry         null.CardDate `iso8583:"14"`
+	RRN            null.String   `iso8583:"37"`
+	Terminal       null.String   `iso8583:"41"`
+	PINBlock       null.Bytes    `iso8583:"52"`
+	Account        null.Secret   `iso8583:"102"`
+	Ignored        string        `iso8583:"-"`
+	Untagged       null.Int64
+}
+
+func TestSpecMarshalUnmarshal(t *testing.T) {
+	in := authorization{
+		MTI:            "0100",
+		PAN:            null.PANFromMustString("4111111111111111"),
+		ProcessingCode: null.StringFrom("000000"),
+		Amount:         null.Int64From(1000),
+		Expiry:         null.CardDateFromMustString("09/27"),
+		RRN:            null.StringFrom("ABC123"),
+		PINBlock:       null.BytesFrom([]byte{1, 2, 3, 4, 5, 6, 7, 8}),
+		Account:        null.SecretFrom("DE89 3704"),
+	}
+	data, err := testSpec.Marshal(&in)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m, err := testSpec.Unpack(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m.Fields[41]; ok {
+		t.Error("null Terminal should leave field 41 out")
+	}
+	if got := string(m.Fields[14]); got != "2709" {
+		t.Errorf("field 14 got = %q, want 2709", got)
+	}
+	if got := string(m.Fields[37]); got != "ABC123      " {
+		t.Errorf("field 37 got = %q, want padded", got)
+	}
+
+	out := authorization{Terminal: null.StringFrom("stale")}
+	if err := testSpec.Unmarshal(data, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.MTI != in.MTI || out.PAN != in.PAN || out.ProcessingCode != in.ProcessingCode ||
+		out.Amount != in.Amount || out.Expiry != in.Expiry || out.RRN != in.RRN ||
+		string(out.PINBlock.Bytes) != string(in.PINBlock.Bytes) || out.Account.Reveal() != in.Account.Reveal() {
+		t.Errorf("Unmarshal() got = %#v, want %#v", out, in)
+	}
+	if out.Terminal.Valid {
+		t.Error("absent field 41 should unmarshal as null")
+	}
+}
+
+func TestSpecUnmarshalBCDExpiry(t *testing.T) {
+	spec := &Spec{Fields: map[int]Field{14: {Type: TypeB, Max: 2}}}
+	var v struct {
+		MTI    null.String   `iso8583:"mti"`
+		Expiry null.CardDate `iso8583:"14"`
+	}
+	v.MTI = null.StringFrom("0100")
+	v.Expiry = null.CardDateFromMustString("09/27")
+	data, err := spec.Marshal(&v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := data[len(data)-2:]; got[0] != 0x27 || got[1] != 0x09 {
+		t.Errorf("Marshal() BCD expiry got = % x", got)
+	}
+	v.Expiry = null.CardDate{}
+	if err := spec.Unmarshal(data, &v); err != nil || v.Expiry != null.CardDateFromMustString("09/27") {
+		t.Errorf("Unmarshal() got = %v, %v", v.Expiry, err)
+	}
+}
+
+func TestSpecUnmarshalErrors(t *testing.T) {
+	data, _ := testSpec.Pack(&Message{MTI: "0100", Fields: map[int][]byte{
+		2:  []byte("4111111111111112"),
+		14: []byte("2713"),
+	}})
+
+	var pan struct {
+		PAN null.PAN `iso8583:"2"`
+	}
+	err := testSpec.Unmarshal(data, &pan)
+	var fieldErr *FieldError
+	if !errors.Is(err, null.ErrInvalidPANLuhn) || !errors.As(err, &fieldErr) || fieldErr.Field != 2 {
+		t.Errorf("Unmarshal() PAN error = %v", err)
+	}
+
+	var expiry struct {
+		Expiry null.CardDate `iso8583:"14"`
+	}
+	if err := testSpec.Unmarshal(data, &expiry); !errors.Is(err, null.ErrInvalidMonth) {
+		t.Errorf("Unmarshal() expiry error = %v, want %v", err, null.ErrInvalidMonth)
+	}
+
+	var wrongType struct {
+		Amount null.Float64 `iso8583:"4"`
+	}
+	if err := testSpec.Unmarshal(data, &wrongType); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Unmarshal() error = %v, want %v", err, ErrUnsupportedType)
+	}
+
+	var stringOfBinary struct {
+		PIN null.String `iso8583:"52"`
+	}
+	stringOfBinary.PIN = null.StringFrom("x")
+	if _, err := testSpec.Marshal(&stringOfBinary); !errors.Is(err, ErrUnsupportedType) {
+		t.Errorf("Marshal() error = %v, want %v", err, ErrUnsupportedType)
+	}
+
+	var badTag struct {
+		X null.String `iso8583:"x"`
+	}
+	if err := testSpec.Unmarshal(data, &badTag); err == nil {
+		t.Error("Unmarshal() with a bad tag should fail")
+	}
+	if err := testSpec.Unmarshal(data, pan); err == nil {
+		t.Error("Unmarshal() into a non-pointer should fail")
+	}
+}
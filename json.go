@@ -134,6 +134,23 @@ func (j JSON) Ptr() *[]byte {
 	return &j.JSON
 }
 
+// ValueOr returns this JSON's value, or def if this JSON is null.
+func (j JSON) ValueOr(def []byte) []byte {
+	if !j.Valid {
+		return def
+	}
+	return j.JSON
+}
+
+// ValueOrZero returns this JSON's value, or the zero value of []byte if this
+// JSON is null.
+func (j JSON) ValueOrZero() []byte {
+	if !j.Valid {
+		return nil
+	}
+	return j.JSON
+}
+
 // IsZero returns true for null or zero JSON's, for future omitempty support (Go 1.4?)
 func (j JSON) IsZero() bool {
 	return !j.Valid
@@ -0,0 +1,319 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// ErrMergePatchTarget is returned by ApplyMergePatch for a patch that is
+// not a JSON object, or a target that is not a pointer to a struct.
+var ErrMergePatchTarget = errors.New("null: merge patch must be an object applied to a struct")
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+
+// ApplyMergePatch applies the RFC 7396 JSON merge patch document patch to
+// the struct target points to, matching members to fields by their JSON
+// names as encoding/json does, and returns the JSON pointers (RFC 6901) of
+// the fields it changed.
+//
+// A member set to null makes the field null: a type of the package becomes
+// invalid, a pointer, map, slice or interface becomes nil and a struct is
+// zeroed, while a field that cannot be null, such as a string, fails with
+// ErrNullNotNullable. An absent member leaves the field untouched. An object
+// is merged into a struct, a pointer to a struct, a map or a valid object in
+// a JSON field; any other member replaces the field. Unknown members are
+// ignored.
+//
+// target is only modified if the whole patch applies.
+func ApplyMergePatch(target interface{}, patch []byte) ([]string, error) {
+	rv := reflect.ValueOf(target)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("%w: target is %T", ErrMergePatchTarget, target)
+	}
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(patch, &members); err != nil || members == nil {
+		return nil, fmt.Errorf("%w: %s", ErrMergePatchTarget, patchExcerpt(patch))
+	}
+
+	mp := mergePatcher{}
+	v := reflect.New(rv.Elem().Type()).Elem()
+	v.Set(rv.Elem())
+	if err := mp.mergeStruct(v, members, ""); err != nil {
+		return nil, err
+	}
+	rv.Elem().Set(v)
+	return mp.changed, nil
+}
+
+type mergePatcher struct {
+	changed []string
+}
+
+func (mp *mergePatcher) mergeStruct(v reflect.Value, members map[string]json.RawMessage, path string) error {
+	for _, f := range jsonFields(v.Type()) {
+		raw, ok := members[f.name]
+		if !ok {
+			if raw, ok = foldMember(members, f.name); !ok {
+				continue
+			}
+		}
+		if err := mp.mergeField(v.FieldByIndex(f.index), raw, path+"/"+escapeJSONPointer(f.name)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mergeField merges raw into v, which must be settable.
+func (mp *mergePatcher) mergeField(v reflect.Value, raw json.RawMessage, path string) error {
+	t := v.Type()
+	isObject := bytes.HasPrefix(bytes.TrimLeft(raw, " \t\r\n"), []byte("{"))
+	switch {
+	case bytes.Equal(raw, NullBytes):
+		return mp.setNull(v, path)
+	case isObject && t == reflect.TypeOf(JSON{}):
+		return mp.mergeJSON(v, raw, path)
+	case isObject && t.Kind() == reflect.Struct && !reflect.PtrTo(t).Implements(jsonUnmarshalerType):
+		return mp.mergeObject(v, raw, path, mp.mergeStruct)
+	case isObject && t.Kind() == reflect.Ptr && t.Elem().Kind() == reflect.Struct &&
+		!t.Implements(jsonUnmarshalerType):
+		elem := reflect.New(t.Elem())
+		if !v.IsNil() {
+			elem.Elem().Set(v.Elem())
+		}
+		if err := mp.mergeObject(elem.Elem(), raw, path, mp.mergeStruct); err != nil {
+			return err
+		}
+		if v.IsNil() || !reflect.DeepEqual(v.Elem().Interface(), elem.Elem().Interface()) {
+			v.Set(elem)
+		}
+		return nil
+	case isObject && t.Kind() == reflect.Map && t.Key().Kind() == reflect.String:
+		return mp.mergeObject(v, raw, path, mp.mergeMap)
+	case isObject && t.Kind() == reflect.Interface && t.NumMethod() == 0:
+		var doc, patch interface{}
+		if err := json.Unmarshal(raw, &patch); err != nil {
+			return fmt.Errorf("null: merge patch %s: %w", path, err)
+		}
+		// Work on a copy, mergeDocument modifies doc in place.
+		if data, err := json.Marshal(v.Interface()); err == nil {
+			_ = json.Unmarshal(data, &doc)
+		}
+		mp.set(v, reflect.ValueOf(mergeDocument(doc, patch)), path)
+		return nil
+	}
+
+	n := reflect.New(t)
+	if err := json.Unmarshal(raw, n.Interface()); err != nil {
+		return fmt.Errorf("null: merge patch %s: %w", path, err)
+	}
+	mp.set(v, n.Elem(), path)
+	return nil
+}
+
+func (mp *mergePatcher) mergeObject(v reflect.Value, raw json.RawMessage, path string,
+	merge func(reflect.Value, map[string]json.RawMessage, string) error) error {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(raw, &members); err != nil {
+		return fmt.Errorf("null: merge patch %s: %w", path, err)
+	}
+	return merge(v, members, path)
+}
+
+func (mp *mergePatcher) mergeMap(v reflect.Value, members map[string]json.RawMessage, path string) error {
+	t := v.Type()
+	m := reflect.MakeMapWithSize(t, v.Len()+len(members))
+	for it := v.MapRange(); it.Next(); {
+		m.SetMapIndex(it.Key(), it.Value())
+	}
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		raw := members[name]
+		key := reflect.ValueOf(name).Convert(t.Key())
+		elemPath := path + "/" + escapeJSONPointer(name)
+		old := m.MapIndex(key)
+		if bytes.Equal(raw, NullBytes) {
+			if old.IsValid() {
+				m.SetMapIndex(key, reflect.Value{})
+				mp.changed = append(mp.changed, elemPath)
+			}
+			continue
+		}
+		elem := reflect.New(t.Elem()).Elem()
+		if old.IsValid() {
+			elem.Set(old)
+		}
+		if err := mp.mergeField(elem, raw, elemPath); err != nil {
+			return err
+		}
+		m.SetMapIndex(key, elem)
+	}
+	if v.IsNil() && m.Len() == 0 {
+		return nil
+	}
+	v.Set(m)
+	return nil
+}
+
+// mergeJSON merges an object into a JSON field, replacing it if it does not
+// hold an object.
+func (mp *mergePatcher) mergeJSON(v reflect.Value, raw json.RawMessage, path string) error {
+	var doc, patch interface{}
+	if err := json.Unmarshal(raw, &patch); err != nil {
+		return fmt.Errorf("null: merge patch %s: %w", path, err)
+	}
+	if j := v.Interface().(JSON); j.Valid {
+		if err := json.Unmarshal(j.JSON, &doc); err != nil {
+			doc = nil
+		}
+	}
+	data, err := json.Marshal(mergeDocument(doc, patch))
+	if err != nil {
+		return fmt.Errorf("null: merge patch %s: %w", path, err)
+	}
+	if j := v.Interface().(JSON); j.Valid && jsonEqual(j.JSON, data) {
+		return nil
+	}
+	mp.set(v, reflect.ValueOf(JSONFrom(data)), path)
+	return nil
+}
+
+// mergeDocument is the MergePatch function of RFC 7396 for decoded JSON.
+func mergeDocument(doc, patch interface{}) interface{} {
+	p, ok := patch.(map[string]interface{})
+	if !ok {
+		return patch
+	}
+	d, ok := doc.(map[string]interface{})
+	if !ok {
+		d = make(map[string]interface{}, len(p))
+	}
+	for k, v := range p {
+		if v == nil {
+			delete(d, k)
+		} else {
+			d[k] = mergeDocument(d[k], v)
+		}
+	}
+	return d
+}
+
+func (mp *mergePatcher) setNull(v reflect.Value, path string) error {
+	if _, ok := v.Addr().Interface().(optionalField); ok {
+		// An Optional records the null, rather than becoming absent.
+		n := reflect.New(v.Type())
+		if err := n.Interface().(json.Unmarshaler).UnmarshalJSON(NullBytes); err != nil {
+			return fmt.Errorf("null: merge patch %s: %w", path, err)
+		}
+		mp.set(v, n.Elem(), path)
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Interface, reflect.Struct:
+		mp.set(v, reflect.Zero(v.Type()), path)
+		return nil
+	}
+	return fmt.Errorf("null: merge patch %s: %w", path, ErrNullNotNullable)
+}
+
+// set stores n into v and records path if that changes v.
+func (mp *mergePatcher) set(v, n reflect.Value, path string) {
+	if reflect.DeepEqual(v.Interface(), n.Interface()) {
+		return
+	}
+	v.Set(n)
+	mp.changed = append(mp.changed, path)
+}
+
+// jsonField is a struct field and its name in JSON.
+type jsonField struct {
+	name  string
+	index []int
+	// omitEmpty and omitZero are the omitempty and omitzero options.
+	omitEmpty, omitZero bool
+}
+
+// jsonFields lists the fields of the struct type t encoding/json encodes,
+// with the fields of embedded structs promoted.
+func jsonFields(t reflect.Type) []jsonField {
+	var fields []jsonField
+	seen := make(map[string]bool)
+	var walk func(t reflect.Type, index []int)
+	walk = func(t reflect.Type, index []int) {
+		var nested []reflect.StructField
+		for i := 0; i < t.NumField(); i++ {
+			sf := t.Field(i)
+			tag := sf.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			opts := strings.Split(tag, ",")
+			name := opts[0]
+			if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+				nested = append(nested, sf)
+				continue
+			}
+			if sf.PkgPath != "" {
+				continue
+			}
+			if name == "" {
+				name = sf.Name
+			}
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+			f := jsonField{name: name, index: append(append([]int(nil), index...), i)}
+			for _, opt := range opts[1:] {
+				f.omitEmpty = f.omitEmpty || opt == "omitempty"
+				f.omitZero = f.omitZero || opt == "omitzero"
+			}
+			fields = append(fields, f)
+		}
+		for _, sf := range nested {
+			walk(sf.Type, append(append([]int(nil), index...), sf.Index...))
+		}
+	}
+	walk(t, nil)
+	return fields
+}
+
+// foldMember finds the member matching name case-insensitively, as
+// encoding/json does.
+func foldMember(members map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	for k, raw := range members {
+		if strings.EqualFold(k, name) {
+			return raw, true
+		}
+	}
+	return nil, false
+}
+
+// escapeJSONPointer escapes a reference token of a JSON pointer.
+func escapeJSONPointer(s string) string {
+	return strings.NewReplacer("~", "~0", "/", "~1").Replace(s)
+}
+
+func jsonEqual(a, b []byte) bool {
+	var x, y interface{}
+	if json.Unmarshal(a, &x) != nil || json.Unmarshal(b, &y) != nil {
+		return bytes.Equal(a, b)
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+func patchExcerpt(b []byte) string {
+	if len(b) > 32 {
+		return string(b[:32]) + "..."
+	}
+	return string(b)
+}
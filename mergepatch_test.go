@@ -0,0 +1,129 @@
+package null
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type mergeAddress struct {
+	Street String `json:"street"`
+	City   String `json:"city"`
+}
+
+type mergeAudit struct {
+	Note String `json:"note"`
+}
+
+type mergeCustomer struct {
+	mergeAudit
+	Name     String            `json:"name"`
+	Age      Int64             `json:"age,omitempty"`
+	Email    string            `json:"email"`
+	Prefs    JSON              `json:"prefs"`
+	Address  mergeAddress      `json:"address"`
+	Billing  *mergeAddress     `json:"billing"`
+	Tags     map[string]string `json:"tags"`
+	Nickname Optional[string]  `json:"nickname"`
+	Ignored  String            `json:"-"`
+	Score    Float64
+}
+
+func newMergeCustomer() mergeCustomer {
+	return mergeCustomer{
+		mergeAudit: mergeAudit{Note: StringFrom("vip")},
+		Name:       StringFrom("Ada"),
+		Age:        Int64From(36),
+		Email:      "ada@example.com",
+		Prefs:      JSONFrom([]byte(`{"lang":"en","theme":"dark"}`)),
+		Address:    mergeAddress{Street: StringFrom("1 Main St"), City: StringFrom("London")},
+		Tags:       map[string]string{"a": "1", "b": "2"},
+		Ignored:    StringFrom("keep"),
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	c := newMergeCustomer()
+	billing := c.Billing
+	changed, err := ApplyMergePatch(&c, []byte(`{
+		"name": "Ada L.",
+		"age": null,
+		"note": null,
+		"prefs": {"theme": null, "tz": "UTC"},
+		"address": {"city": "Paris", "zip": "75001"},
+		"billing": {"city": "Lyon"},
+		"tags": {"a": null, "c": "3"},
+		"nickname": null,
+		"-": "x",
+		"Ignored": "x",
+		"score": 1.5,
+		"unknown": true
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := newMergeCustomer()
+	want.Note = String{}
+	want.Name = StringFrom("Ada L.")
+	want.Age = Int64{}
+	want.Prefs = JSONFrom([]byte(`{"lang":"en","tz":"UTC"}`))
+	want.Address.City = StringFrom("Paris")
+	want.Billing = &mergeAddress{City: StringFrom("Lyon")}
+	want.Tags = map[string]string{"b": "2", "c": "3"}
+	want.Nickname = OptionalNull[string]()
+	want.Score = Float64From(1.5)
+	if !reflect.DeepEqual(c, want) {
+		t.Errorf("got  %+v\nwant %+v", c, want)
+	}
+	if billing != nil {
+		t.Error("billing should have been nil before")
+	}
+
+	wantChanged := []string{"/name", "/age", "/prefs", "/address/city", "/billing/city",
+		"/tags/a", "/tags/c", "/nickname", "/Score", "/note"}
+	if !reflect.DeepEqual(changed, wantChanged) {
+		t.Errorf("changed = %v, want %v", changed, wantChanged)
+	}
+}
+
+func TestApplyMergePatchUnchanged(t *testing.T) {
+	c := newMergeCustomer()
+	changed, err := ApplyMergePatch(&c, []byte(`{"name":"Ada","prefs":{"lang":"en"},"address":{}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(changed) != 0 {
+		t.Errorf("changed = %v, want none", changed)
+	}
+	if !reflect.DeepEqual(c, newMergeCustomer()) {
+		t.Errorf("got %+v, want unchanged", c)
+	}
+}
+
+func TestApplyMergePatchErrors(t *testing.T) {
+	c := newMergeCustomer()
+	tests := []struct {
+		name   string
+		target interface{}
+		patch  string
+		err    error
+	}{
+		{"array patch", &c, `[1]`, ErrMergePatchTarget},
+		{"null patch", &c, `null`, ErrMergePatchTarget},
+		{"non-pointer target", c, `{}`, ErrMergePatchTarget},
+		{"null into string", &c, `{"name":"Bob","email":null}`, ErrNullNotNullable},
+		{"wrong type", &c, `{"name":"Bob","age":"x"}`, nil},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ApplyMergePatch(test.target, []byte(test.patch))
+			if err == nil || test.err != nil && !errors.Is(err, test.err) {
+				t.Errorf("err = %v, want %v", err, test.err)
+			}
+		})
+	}
+	if !reflect.DeepEqual(c, newMergeCustomer()) {
+		t.Errorf("failed patch modified the target: %+v", c)
+	}
+}
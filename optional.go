@@ -0,0 +1,192 @@
+package null
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrNullNotNullable is returned when a null Optional is applied to a field
+// that cannot hold null.
+var ErrNullNotNullable = errors.New("null: cannot apply null to a non-nullable field")
+
+// Optional is a nullable T that also records whether it was present in the
+// decoded JSON, to tell an omitted field (Set false) from an explicit null
+// (Set true, Valid false) and a value (Set and Valid true). It is meant for
+// the bodies of PATCH requests; see ApplyOptional and ApplyOptionals.
+//
+// An omitted Optional is marshalled as null. IsZero reports an omitted
+// Optional, so with Go 1.24 or later a field tagged json:",omitzero" is left
+// out of the output instead; older versions ignore the option.
+type Optional[T any] struct {
+	V     T
+	Valid bool
+	Set   bool
+}
+
+// OptionalFrom creates a new Optional that is set and valid.
+func OptionalFrom[T any](v T) Optional[T] {
+	return Optional[T]{V: v, Valid: true, Set: true}
+}
+
+// OptionalNull creates a new Optional that is set to null.
+func OptionalNull[T any]() Optional[T] {
+	return Optional[T]{Set: true}
+}
+
+// OptionalOf creates a new Optional that is set to v.
+func OptionalOf[T any](v Value[T]) Optional[T] {
+	return Optional[T]{V: v.V, Valid: v.Valid, Set: true}
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It is only called for a field
+// present in the JSON, so it always marks the Optional as set.
+func (o *Optional[T]) UnmarshalJSON(data []byte) error {
+	var v Value[T]
+	if !bytes.Equal(data, NullBytes) {
+		if err := v.UnmarshalJSON(data); err != nil {
+			return err
+		}
+	}
+	*o = OptionalOf(v)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (o Optional[T]) MarshalJSON() ([]byte, error) {
+	return o.ToValue().MarshalJSON()
+}
+
+// SetValid changes this Optional's value and sets it to be set and non-null.
+func (o *Optional[T]) SetValid(v T) {
+	*o = OptionalFrom(v)
+}
+
+// Ptr returns a pointer to this Optional's value, or a nil pointer if this
+// Optional is null or not set.
+func (o Optional[T]) Ptr() *T {
+	if !o.Valid {
+		return nil
+	}
+	return &o.V
+}
+
+// IsZero returns true for an Optional that is not set.
+func (o Optional[T]) IsZero() bool {
+	return !o.Set
+}
+
+// ToValue returns the Optional as a Value, null if it is not set.
+func (o Optional[T]) ToValue() Value[T] {
+	if !o.Set {
+		return Value[T]{}
+	}
+	return NewValue(o.V, o.Valid)
+}
+
+// ApplyOptional stores o into dst if o is set and reports whether it did.
+// dst is any type of the package with a SetValid(T) method, such as *Int64,
+// *String or *Value[T]; a null o sets dst to its zero value, which is null.
+func ApplyOptional[T any, N any, PN interface {
+	*N
+	SetValid(T)
+}](dst PN, o Optional[T]) bool {
+	if !o.Set {
+		return false
+	}
+	if o.Valid {
+		dst.SetValid(o.V)
+	} else {
+		var zero N
+		*dst = zero
+	}
+	return true
+}
+
+// optionalField is implemented by every Optional, for ApplyOptionals.
+type optionalField interface {
+	isSet() bool
+	assign(dst reflect.Value) error
+}
+
+func (o Optional[T]) isSet() bool {
+	return o.Set
+}
+
+// assign stores o into dst, a T, *T, Value[T], Optional[T] or a type
+// with a SetValid(T) method such as Int64.
+func (o Optional[T]) assign(dst reflect.Value) error {
+	switch p := dst.Addr().Interface().(type) {
+	case *Optional[T]:
+		*p = o
+	case *Value[T]:
+		*p = o.ToValue()
+	case **T:
+		*p = o.Ptr()
+	case *T:
+		if !o.Valid {
+			return ErrNullNotNullable
+		}
+		*p = o.V
+	case interface{ SetValid(T) }:
+		if o.Valid {
+			p.SetValid(o.V)
+		} else {
+			dst.Set(reflect.Zero(dst.Type()))
+		}
+	default:
+		var zero T
+		return fmt.Errorf("null: cannot apply Optional[%T] to %s", zero, dst.Type())
+	}
+	return nil
+}
+
+// ApplyOptionals copies the set Optional fields of the struct patch points
+// to onto the fields of the same name of the struct dst points to, and
+// returns the names of the fields whose value changed. A target field is a T,
+// a *T, a Value[T], an Optional[T] or a type of the package with a
+// SetValid(T) method such as Int64; a null Optional sets it to null, or to
+// nil for a *T, and fails with ErrNullNotNullable for a T.
+//
+// dst is only modified if every set field applies.
+func ApplyOptionals(dst, patch interface{}) ([]string, error) {
+	dv, pv := reflect.ValueOf(dst), reflect.ValueOf(patch)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: ApplyOptionals target must be a non-nil pointer to a struct, not %T", dst)
+	}
+	if pv.Kind() == reflect.Ptr && !pv.IsNil() {
+		pv = pv.Elem()
+	}
+	if pv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("null: ApplyOptionals patch must be a struct, not %T", patch)
+	}
+	v := reflect.New(dv.Elem().Type()).Elem()
+	v.Set(dv.Elem())
+
+	var changed []string
+	for i := 0; i < pv.NumField(); i++ {
+		sf := pv.Type().Field(i)
+		if sf.PkgPath != "" {
+			continue
+		}
+		o, ok := pv.Field(i).Interface().(optionalField)
+		if !ok || !o.isSet() {
+			continue
+		}
+		target := v.FieldByName(sf.Name)
+		if !target.IsValid() || !target.CanSet() {
+			return nil, fmt.Errorf("null: ApplyOptionals target has no field %s", sf.Name)
+		}
+		old := reflect.New(target.Type()).Elem()
+		old.Set(target)
+		if err := o.assign(target); err != nil {
+			return nil, fmt.Errorf("null: field %s: %w", sf.Name, err)
+		}
+		if !reflect.DeepEqual(old.Interface(), target.Interface()) {
+			changed = append(changed, sf.Name)
+		}
+	}
+	dv.Elem().Set(v)
+	return changed, nil
+}
@@ -0,0 +1,143 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+type accountPatch struct {
+	Name     Optional[string] `json:"name"`
+	Limit    Optional[int64]  `json:"limit"`
+	Nickname Optional[string] `json:"nickname"`
+	Active   Optional[bool]   `json:"active"`
+}
+
+type account struct {
+	Name     string
+	Limit    Int64
+	Nickname *string
+	Active   Value[bool]
+}
+
+func TestOptionalJSON(t *testing.T) {
+	var p accountPatch
+	err := json.Unmarshal([]byte(`{"name":"Ada","limit":null}`), &p)
+	maybePanic(err)
+
+	if !p.Name.Set || !p.Name.Valid || p.Name.V != "Ada" {
+		t.Errorf("name got = %+v, want set value", p.Name)
+	}
+	if !p.Limit.Set || p.Limit.Valid {
+		t.Errorf("limit got = %+v, want set null", p.Limit)
+	}
+	if p.Nickname.Set || p.Nickname.Valid {
+		t.Errorf("nickname got = %+v, want absent", p.Nickname)
+	}
+	if !p.Nickname.IsZero() || p.Limit.IsZero() {
+		t.Error("IsZero should only be true for an absent Optional")
+	}
+
+	data, err := json.Marshal(p)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"name":"Ada","limit":null,"nickname":null,"active":null}`, "Optional json")
+
+	if err := json.Unmarshal([]byte(`{"limit":"x"}`), &p); err == nil {
+		t.Error("expected error for wrong type")
+	}
+}
+
+func TestOptionalConstructors(t *testing.T) {
+	if o := OptionalFrom(int64(1)); !o.Set || !o.Valid || *o.Ptr() != 1 {
+		t.Errorf("OptionalFrom got = %+v", o)
+	}
+	if o := OptionalNull[int64](); !o.Set || o.Valid || o.Ptr() != nil {
+		t.Errorf("OptionalNull got = %+v", o)
+	}
+	if o := OptionalOf(NewValue("a", false)); !o.Set || o.Valid {
+		t.Errorf("OptionalOf got = %+v", o)
+	}
+	var o Optional[int64]
+	if v := o.ToValue(); v.Valid {
+		t.Errorf("ToValue of absent got = %+v", v)
+	}
+	o.SetValid(2)
+	if !o.Set || !o.Valid || o.V != 2 {
+		t.Errorf("SetValid got = %+v", o)
+	}
+}
+
+func TestApplyOptional(t *testing.T) {
+	i := Int64From(1)
+	if ApplyOptional(&i, Optional[int64]{}) || i != Int64From(1) {
+		t.Errorf("absent Optional applied: %+v", i)
+	}
+	if !ApplyOptional(&i, OptionalFrom(int64(2))) || i != Int64From(2) {
+		t.Errorf("got %+v, want 2", i)
+	}
+	if !ApplyOptional(&i, OptionalNull[int64]()) || i.Valid {
+		t.Errorf("got %+v, want null", i)
+	}
+
+	s := StringFrom("a")
+	ApplyOptional(&s, OptionalNull[string]())
+	if s.Valid {
+		t.Errorf("got %+v, want null", s)
+	}
+
+	v := ValueFrom(1.5)
+	ApplyOptional(&v, OptionalFrom(2.5))
+	if v != ValueFrom(2.5) {
+		t.Errorf("got %+v, want 2.5", v)
+	}
+}
+
+func TestApplyOptionals(t *testing.T) {
+	nick := "ada"
+	acc := account{Name: "Ada", Limit: Int64From(100), Nickname: &nick, Active: ValueFrom(true)}
+
+	var p accountPatch
+	err := json.Unmarshal([]byte(`{"limit":250,"nickname":null}`), &p)
+	maybePanic(err)
+
+	changed, err := ApplyOptionals(&acc, p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"Limit", "Nickname"}; !reflect.DeepEqual(changed, want) {
+		t.Errorf("changed = %v, want %v", changed, want)
+	}
+	want := account{Name: "Ada", Limit: Int64From(250), Active: ValueFrom(true)}
+	if !reflect.DeepEqual(acc, want) {
+		t.Errorf("got %+v, want %+v", acc, want)
+	}
+
+	p = accountPatch{Limit: OptionalFrom(int64(250)), Active: OptionalFrom(true)}
+	if changed, err := ApplyOptionals(&acc, p); err != nil || len(changed) != 0 {
+		t.Errorf("unchanged values: changed = %v, %v", changed, err)
+	}
+
+	p = accountPatch{Name: OptionalNull[string]()}
+	if _, err := ApplyOptionals(&acc, &p); !errors.Is(err, ErrNullNotNullable) {
+		t.Errorf("null into string: err = %v, want ErrNullNotNullable", err)
+	}
+
+	wrong := struct {
+		Name  string
+		Limit string
+	}{Name: "Ada"}
+	if _, err := ApplyOptionals(&wrong, accountPatch{Name: OptionalFrom("Bob"), Limit: OptionalFrom(int64(1))}); err == nil {
+		t.Error("expected error for mismatched field type")
+	}
+	if wrong.Name != "Ada" {
+		t.Errorf("failed ApplyOptionals wrote Name = %q", wrong.Name)
+	}
+	var missing struct{}
+	if _, err := ApplyOptionals(&missing, accountPatch{Limit: OptionalFrom(int64(1))}); err == nil {
+		t.Error("expected error for missing field")
+	}
+	if _, err := ApplyOptionals(acc, p); err == nil {
+		t.Error("expected error for non-pointer target")
+	}
+}
@@ -0,0 +1,315 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// vars
+var (
+	ErrInvalidPANLength = errors.New("invalid length of card number")
+	ErrInvalidPANDigit  = errors.New("invalid character in card number")
+	ErrInvalidPANLuhn   = errors.New("invalid check digit of card number")
+)
+
+const (
+	panMinLength = 12
+	panMaxLength = 19
+)
+
+// CardBrand is a payment card scheme detected from the IIN of a card number.
+type CardBrand string
+
+// Card brands known to PAN.Brand.
+const (
+	CardBrandUnknown    CardBrand = ""
+	CardBrandVisa       CardBrand = "visa"
+	CardBrandMastercard CardBrand = "mastercard"
+	CardBrandAmex       CardBrand = "amex"
+	CardBrandDiscover   CardBrand = "discover"
+	CardBrandDinersClub CardBrand = "diners"
+	CardBrandJCB        CardBrand = "jcb"
+	CardBrandUnionPay   CardBrand = "unionpay"
+	CardBrandMaestro    CardBrand = "maestro"
+	CardBrandMir        CardBrand = "mir"
+)
+
+// iinRanges maps IIN prefixes to brands. The first matching range wins, so
+// narrower ranges come before the wider ones they overlap.
+var iinRanges = []struct {
+	digits   int
+	from, to int
+	brand    CardBrand
+}{
+	{digits: 6, from: 622126, to: 622925, brand: CardBrandDiscover},
+	{digits: 4, from: 6011, to: 6011, brand: CardBrandDiscover},
+	{digits: 3, from: 644, to: 649, brand: CardBrandDiscover},
+	{digits: 2, from: 65, to: 65, brand: CardBrandDiscover},
+	{digits: 2, from: 62, to: 62, brand: CardBrandUnionPay},
+	{digits: 2, from: 34, to: 34, brand: CardBrandAmex},
+	{digits: 2, from: 37, to: 37, brand: CardBrandAmex},
+	{digits: 4, from: 3528, to: 3589, brand: CardBrandJCB},
+	{digits: 3, from: 300, to: 305, brand: CardBrandDinersClub},
+	{digits: 2, from: 36, to: 36, brand: CardBrandDinersClub},
+	{digits: 2, from: 38, to: 39, brand: CardBrandDinersClub},
+	{digits: 4, from: 2200, to: 2204, brand: CardBrandMir},
+	{digits: 4, from: 2221, to: 2720, brand: CardBrandMastercard},
+	{digits: 2, from: 51, to: 55, brand: CardBrandMastercard},
+	{digits: 4, from: 6304, to: 6304, brand: CardBrandMaestro},
+	{digits: 4, from: 6759, to: 6759, brand: CardBrandMaestro},
+	{digits: 2, from: 50, to: 50, brand: CardBrandMaestro},
+	{digits: 2, from: 56, to: 58, brand: CardBrandMaestro},
+	{digits: 1, from: 4, to: 4, brand: CardBrandVisa},
+}
+
+// PAN is a nullable primary account number, i.e. a payment card number.
+// It is validated for length and Luhn check digit on every entry point and
+// is rendered masked (411111******1111) by String, GoString, MarshalText and
+// MarshalJSON, so it does not leak into logs or API responses.
+// The clear number is only available through Reveal and Value.
+type PAN struct {
+	number string
+	Valid  bool
+}
+
+// PANFromString creates a new valid PAN, if s is a valid card number.
+// Spaces and dashes are removed. Else return error and invalid struct.
+func PANFromString(s string) (PAN, error) {
+	number, err := normalizePAN(s)
+	if err != nil {
+		return PAN{}, err
+	}
+	return PAN{number: number, Valid: true}, nil
+}
+
+// PANFromMustString is like PANFromString but panics on an invalid number.
+// NOTE: Use only for TEST purposes!
+func PANFromMustString(s string) PAN {
+	p, err := PANFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// Reveal returns the clear card number, or an empty string if null.
+func (p PAN) Reveal() string {
+	if p.isNull() {
+		return ""
+	}
+	return p.number
+}
+
+// BIN returns the first six digits of the card number, the bank
+// identification number (IIN), or an empty string if null.
+func (p PAN) BIN() string {
+	if p.isNull() {
+		return ""
+	}
+	return p.number[:6]
+}
+
+// LastFour returns the last four digits of the card number,
+// or an empty string if null.
+func (p PAN) LastFour() string {
+	if p.isNull() {
+		return ""
+	}
+	return p.number[len(p.number)-4:]
+}
+
+// Brand detects the card brand from the IIN ranges of the card number.
+func (p PAN) Brand() CardBrand {
+	if p.isNull() {
+		return CardBrandUnknown
+	}
+	for _, r := range iinRanges {
+		prefix := 0
+		for _, c := range p.number[:r.digits] {
+			prefix = prefix*10 + int(c-'0')
+		}
+		if prefix >= r.from && prefix <= r.to {
+			return r.brand
+		}
+	}
+	return CardBrandUnknown
+}
+
+// Mask returns the card number with all but the first six and the last four
+// digits replaced by '*', or an empty string if null.
+func (p PAN) Mask() string {
+	if p.isNull() {
+		return ""
+	}
+	return p.number[:6] + strings.Repeat("*", len(p.number)-10) + p.number[len(p.number)-4:]
+}
+
+// String returns the masked card number, or "null".
+func (p PAN) String() string {
+	if p.isNull() {
+		return "null"
+	}
+	return p.Mask()
+}
+
+// GoString implements fmt.GoStringer, so %#v is masked as well.
+func (p PAN) GoString() string {
+	if p.isNull() {
+		return "null.PAN{}"
+	}
+	return `null.PAN{"` + p.Mask() + `"}`
+}
+
+// Format implements fmt.Formatter, so every verb writes the masked form.
+func (p PAN) Format(f fmt.State, verb rune) {
+	switch {
+	case verb == 'v' && f.Flag('#'):
+		io.WriteString(f, p.GoString())
+	case verb == 'q':
+		io.WriteString(f, strconv.Quote(p.String()))
+	default:
+		io.WriteString(f, p.String())
+	}
+}
+
+// MarshalJSON implements json.Marshaler. The number is masked.
+func (p PAN) MarshalJSON() ([]byte, error) {
+	if p.isNull() {
+		return NullBytes, nil
+	}
+	return json.Marshal(p.Mask())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (p *PAN) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		p.number, p.Valid = "", false
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		p.number, p.Valid = "", false
+		return err
+	}
+	return p.set(s)
+}
+
+// MarshalText implements encoding.TextMarshaler. The number is masked.
+func (p PAN) MarshalText() ([]byte, error) {
+	if p.isNull() {
+		return []byte{}, nil
+	}
+	return []byte(p.Mask()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *PAN) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		p.number, p.Valid = "", false
+		return nil
+	}
+	return p.set(string(text))
+}
+
+// IsZero returns true for null PANs, for potential future omitempty support.
+func (p PAN) IsZero() bool {
+	return p.isNull()
+}
+
+// isNull reports whether p is null. A PAN built by hand with Valid set but
+// without a number, which cannot be set outside the package, is null too.
+func (p PAN) isNull() bool {
+	return !p.Valid || len(p.number) < panMinLength
+}
+
+// Scan implements the Scanner interface.
+func (p *PAN) Scan(value interface{}) error {
+	switch x := value.(type) {
+	case string:
+		return p.set(x)
+	case []byte:
+		return p.set(string(x))
+	case nil:
+		p.number, p.Valid = "", false
+		return nil
+	}
+	p.number, p.Valid = "", false
+	return fmt.Errorf("null: cannot scan type %T into null.PAN", value)
+}
+
+// Value implements the driver Valuer interface.
+// The clear number is stored, protecting it at rest is up to the database.
+func (p PAN) Value() (driver.Value, error) {
+	if p.isNull() {
+		return nil, nil
+	}
+	return p.number, nil
+}
+
+// Randomize for sqlboiler
+func (p *PAN) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		p.number, p.Valid = "", false
+		return
+	}
+	digits := []byte("4")
+	for len(digits) < 15 {
+		digits = append(digits, byte('0'+nextInt()%10))
+	}
+	digits = append(digits, luhnCheckDigit(string(digits)))
+	p.number, p.Valid = string(digits), true
+}
+
+func (p *PAN) set(s string) error {
+	number, err := normalizePAN(s)
+	if err != nil {
+		p.number, p.Valid = "", false
+		return err
+	}
+	p.number, p.Valid = number, true
+	return nil
+}
+
+// normalizePAN removes spaces and dashes from s and validates the result.
+func normalizePAN(s string) (string, error) {
+	if strings.ContainsAny(s, " -") {
+		s = strings.NewReplacer(" ", "", "-", "").Replace(s)
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigit(s[i]) {
+			return "", ErrInvalidPANDigit
+		}
+	}
+	if len(s) < panMinLength || len(s) > panMaxLength {
+		return "", ErrInvalidPANLength
+	}
+	if luhnCheckDigit(s[:len(s)-1]) != s[len(s)-1] {
+		return "", ErrInvalidPANLuhn
+	}
+	return s, nil
+}
+
+// luhnCheckDigit computes the Luhn check digit to append to the digits s.
+func luhnCheckDigit(s string) byte {
+	sum := 0
+	double := true
+	for i := len(s) - 1; i >= 0; i-- {
+		d := int(s[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return byte('0' + (10-sum%10)%10)
+}
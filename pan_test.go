@@ -0,0 +1,280 @@
+package null
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+const (
+	panVisa   = "4111111111111111"
+	panMasked = "411111******1111"
+)
+
+type panInStruct struct {
+	PAN PAN `json:"pan"`
+}
+
+func TestPANFromString(t *testing.T) {
+	tests := []struct {
+		name string
+		pan  string
+		want string
+		err  error
+	}{
+		{name: "Valid", pan: panVisa, want: panVisa},
+		{name: "Valid with spaces", pan: "4111 1111 1111 1111", want: panVisa},
+		{name: "Valid with dashes", pan: "4111-1111-1111-1111", want: panVisa},
+		{name: "Valid 19 digits", pan: "6304000000000000000", want: "6304000000000000000"},
+		{name: "Invalid check digit", pan: "4111111111111112", err: ErrInvalidPANLuhn},
+		{name: "Too short", pan: "41111111111", err: ErrInvalidPANLength},
+		{name: "Too long", pan: "41111111111111111111", err: ErrInvalidPANLength},
+		{name: "Letters", pan: "4111a11111111111", err: ErrInvalidPANDigit},
+		{name: "Empty", pan: "", err: ErrInvalidPANLength},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p, err := PANFromString(test.pan)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("PANFromString() error = %v, want %v", err, test.err)
+			}
+			if p.Reveal() != test.want {
+				t.Errorf("Reveal() got = %s, want %s", p.Reveal(), test.want)
+			}
+			if p.Valid != (test.err == nil) {
+				t.Errorf("Valid got = %v", p.Valid)
+			}
+		})
+	}
+}
+
+func TestPANFromMustStringPanic(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("PANFromMustString should have panicked!")
+		}
+	}()
+	PANFromMustString("4111111111111112")
+}
+
+func TestPANParts(t *testing.T) {
+	p := PANFromMustString("378282246310005")
+	if p.BIN() != "378282" {
+		t.Errorf("BIN() got = %s, want 378282", p.BIN())
+	}
+	if p.LastFour() != "0005" {
+		t.Errorf("LastFour() got = %s, want 0005", p.LastFour())
+	}
+	if p.Mask() != "378282*****0005" {
+		t.Errorf("Mask() got = %s, want 378282*****0005", p.Mask())
+	}
+
+	var null PAN
+	if null.BIN() != "" || null.LastFour() != "" || null.Mask() != "" || null.Reveal() != "" {
+		t.Error("null PAN should have empty parts")
+	}
+}
+
+func TestPANBrand(t *testing.T) {
+	tests := []struct {
+		pan   string
+		brand CardBrand
+	}{
+		{pan: "4111111111111111", brand: CardBrandVisa},
+		{pan: "5555555555554444", brand: CardBrandMastercard},
+		{pan: "2223003122003222", brand: CardBrandMastercard},
+		{pan: "378282246310005", brand: CardBrandAmex},
+		{pan: "6011111111111117", brand: CardBrandDiscover},
+		{pan: "6221260000000000", brand: CardBrandDiscover},
+		{pan: "6200000000000005", brand: CardBrandUnionPay},
+		{pan: "3566002020360505", brand: CardBrandJCB},
+		{pan: "30569309025904", brand: CardBrandDinersClub},
+		{pan: "6759649826438453", brand: CardBrandMaestro},
+		{pan: "2200000000000004", brand: CardBrandMir},
+		{pan: "9999999999999995", brand: CardBrandUnknown},
+	}
+	for _, test := range tests {
+		t.Run(test.pan, func(t *testing.T) {
+			p := PANFromMustString(fixLuhn(test.pan))
+			if got := p.Brand(); got != test.brand {
+				t.Errorf("Brand() got = %q, want %q", got, test.brand)
+			}
+		})
+	}
+	if got := (PAN{}).Brand(); got != CardBrandUnknown {
+		t.Errorf("Brand() of null got = %q", got)
+	}
+}
+
+func TestPANHandBuilt(t *testing.T) {
+	for _, p := range []PAN{{Valid: true}, {number: "4111", Valid: true}} {
+		for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%d"} {
+			if out := fmt.Sprintf(format, p); out != fmt.Sprintf(format, PAN{}) {
+				t.Errorf("%s of %#v got = %s, want null", format, p, out)
+			}
+		}
+		data, err := json.Marshal(p)
+		maybePanic(err)
+		assertJSONEquals(t, data, "null", "hand built PAN json")
+		if v, err := p.Value(); v != nil || err != nil {
+			t.Errorf("Value() got = %v, %v", v, err)
+		}
+		if p.BIN() != "" || p.LastFour() != "" || p.Mask() != "" || p.Reveal() != "" ||
+			p.Brand() != CardBrandUnknown || !p.IsZero() {
+			t.Errorf("accessors of %#v should act as null", p)
+		}
+	}
+}
+
+func TestPANMasking(t *testing.T) {
+	p := PANFromMustString(panVisa)
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%d", "%X", "%08d"} {
+		out := fmt.Sprintf(format, p)
+		if strings.Contains(out, panVisa) || strings.Contains(out, fmt.Sprintf("%x", panVisa)) {
+			t.Errorf("%s leaks the card number: %s", format, out)
+		}
+	}
+	if out := fmt.Sprintf("%v", panInStruct{p}); out != "{"+panMasked+"}" {
+		t.Errorf("%%v of a struct got = %s", out)
+	}
+	for _, format := range []string{"%d", "%x", "%+v"} {
+		if out := fmt.Sprintf(format, p); out != panMasked {
+			t.Errorf("%s got = %s, want %s", format, out, panMasked)
+		}
+		if out := fmt.Sprintf(format, []PAN{p}); out != "["+panMasked+"]" {
+			t.Errorf("%s of a slice got = %s", format, out)
+		}
+		if out := fmt.Sprintf(format, panInStruct{p}); !strings.Contains(out, panMasked) || strings.Contains(out, panVisa) {
+			t.Errorf("%s of a struct got = %s", format, out)
+		}
+	}
+	if out := fmt.Sprintf("%q", p); out != `"`+panMasked+`"` {
+		t.Errorf("%%q got = %s", out)
+	}
+	if p.String() != panMasked {
+		t.Errorf("String() got = %s, want %s", p.String(), panMasked)
+	}
+	if (PAN{}).String() != "null" {
+		t.Errorf("String() of null got = %s", (PAN{}).String())
+	}
+
+	text, err := p.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, text, panMasked, "masked text")
+
+	data, err := json.Marshal(panInStruct{p})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"pan":"`+panMasked+`"}`, "masked json")
+
+	data, err = json.Marshal(panInStruct{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"pan":null}`, "null json")
+}
+
+func TestUnmarshalPAN(t *testing.T) {
+	var s panInStruct
+	err := json.Unmarshal([]byte(`{"pan":"4111 1111 1111 1111"}`), &s)
+	maybePanic(err)
+	if !s.PAN.Valid || s.PAN.Reveal() != panVisa {
+		t.Errorf("UnmarshalJSON got = %#v", s.PAN)
+	}
+
+	err = json.Unmarshal([]byte(`{"pan":null}`), &s)
+	maybePanic(err)
+	if s.PAN.Valid {
+		t.Error("null json should give null PAN")
+	}
+
+	err = json.Unmarshal([]byte(`{"pan":"`+panMasked+`"}`), &s)
+	if !errors.Is(err, ErrInvalidPANDigit) {
+		t.Errorf("masked json error = %v, want %v", err, ErrInvalidPANDigit)
+	}
+	if s.PAN.Valid {
+		t.Error("invalid json should give null PAN")
+	}
+
+	err = json.Unmarshal([]byte(`{"pan":4111111111111111}`), &s)
+	if err == nil {
+		t.Error("number json should fail")
+	}
+
+	var p PAN
+	maybePanic(p.UnmarshalText([]byte(panVisa)))
+	if p.Reveal() != panVisa {
+		t.Errorf("UnmarshalText got = %#v", p)
+	}
+	maybePanic(p.UnmarshalText([]byte("")))
+	if p.Valid {
+		t.Error("empty text should give null PAN")
+	}
+	if err := p.UnmarshalText([]byte("4111111111111112")); !errors.Is(err, ErrInvalidPANLuhn) {
+		t.Errorf("UnmarshalText error = %v, want %v", err, ErrInvalidPANLuhn)
+	}
+}
+
+func TestPANScanValue(t *testing.T) {
+	var p PAN
+	maybePanic(p.Scan(panVisa))
+	if p.Reveal() != panVisa {
+		t.Errorf("Scan string got = %#v", p)
+	}
+	maybePanic(p.Scan([]byte(panVisa)))
+	if p.Reveal() != panVisa {
+		t.Errorf("Scan bytes got = %#v", p)
+	}
+	v, err := p.Value()
+	maybePanic(err)
+	if v != panVisa {
+		t.Errorf("Value() got = %v, want %s", v, panVisa)
+	}
+
+	maybePanic(p.Scan(nil))
+	if p.Valid {
+		t.Error("Scan(nil) should give null PAN")
+	}
+	v, err = p.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("Value() of null got = %v", v)
+	}
+
+	if err := p.Scan(int64(4111111111111111)); err == nil || p.Valid {
+		t.Error("Scan(int64) should fail")
+	}
+	if err := p.Scan("4111111111111112"); !errors.Is(err, ErrInvalidPANLuhn) || p.Valid {
+		t.Errorf("Scan error = %v, want %v", err, ErrInvalidPANLuhn)
+	}
+}
+
+func TestPANIsZero(t *testing.T) {
+	if PANFromMustString(panVisa).IsZero() {
+		t.Errorf("IsZero() should be false")
+	}
+	if !(PAN{}).IsZero() {
+		t.Errorf("IsZero() should be true")
+	}
+}
+
+func TestPANRandomize(t *testing.T) {
+	var i int64
+	next := func() int64 {
+		i++
+		return i
+	}
+	var p PAN
+	p.Randomize(next, "", false)
+	if _, err := PANFromString(p.Reveal()); err != nil {
+		t.Errorf("Randomize() should give a valid PAN, instead of %s: %v", p.Reveal(), err)
+	}
+	p.Randomize(next, "", true)
+	if p.Valid {
+		t.Error("Randomize() should give null PAN")
+	}
+}
+
+// fixLuhn replaces the last digit of s with its Luhn check digit.
+func fixLuhn(s string) string {
+	return s[:len(s)-1] + string(luhnCheckDigit(s[:len(s)-1]))
+}
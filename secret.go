@@ -0,0 +1,348 @@
+package null
+
+import (
+	"bytes"
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"sync"
+
+	"github.com/metricsglobal/null/convert"
+	"github.com/volatiletech/randomize"
+)
+
+// SecretRedacted replaces the value of a Secret in every output.
+const SecretRedacted = "[REDACTED]"
+
+// ErrRedactedSecret is returned when the redaction placeholder is unmarshalled
+// into a Secret, which usually means a redacted document was sent back.
+var ErrRedactedSecret = errors.New("null: cannot unmarshal redacted value into null.Secret")
+
+// Secret is a nullable string holding sensitive data such as a CVV, a PIN
+// block or an API key. It supports SQL and JSON serialization like String,
+// but MarshalJSON, MarshalText, String, GoString and Format always write
+// SecretRedacted. The value is only available through Reveal, Value and the
+// encoders MarshalRevealed and SecretEncoder.
+type Secret struct {
+	secret string
+	Valid  bool
+	// reveal is only set on the copies made by MarshalRevealed.
+	reveal bool
+}
+
+// NewSecret creates a new Secret
+func NewSecret(s string, valid bool) Secret {
+	return Secret{
+		secret: s,
+		Valid:  valid,
+	}
+}
+
+// SecretFrom creates a new Secret that will never be blank.
+func SecretFrom(s string) Secret {
+	return NewSecret(s, true)
+}
+
+// SecretFromPtr creates a new Secret that be null if s is nil.
+func SecretFromPtr(s *string) Secret {
+	if s == nil {
+		return NewSecret("", false)
+	}
+	return NewSecret(*s, true)
+}
+
+// Reveal returns the clear value, or an empty string if null.
+func (s Secret) Reveal() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.secret
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (s *Secret) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		s.secret = ""
+		s.Valid = false
+		return nil
+	}
+
+	var str string
+	if err := json.Unmarshal(data, &str); err != nil {
+		s.secret, s.Valid = "", false
+		return err
+	}
+	if str == SecretRedacted {
+		s.secret, s.Valid = "", false
+		return ErrRedactedSecret
+	}
+
+	s.secret = str
+	s.Valid = true
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler. The value is redacted.
+func (s Secret) MarshalJSON() ([]byte, error) {
+	if !s.Valid {
+		return NullBytes, nil
+	}
+	if s.reveal {
+		return json.Marshal(s.secret)
+	}
+	return []byte(`"` + SecretRedacted + `"`), nil
+}
+
+// MarshalText implements encoding.TextMarshaler. The value is redacted.
+func (s Secret) MarshalText() ([]byte, error) {
+	if !s.Valid {
+		return []byte{}, nil
+	}
+	return []byte(SecretRedacted), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *Secret) UnmarshalText(text []byte) error {
+	if text == nil || len(text) == 0 {
+		s.secret, s.Valid = "", false
+		return nil
+	}
+	if string(text) == SecretRedacted {
+		s.secret, s.Valid = "", false
+		return ErrRedactedSecret
+	}
+
+	s.secret = string(text)
+	s.Valid = true
+	return nil
+}
+
+// String implements fmt.Stringer. The value is redacted.
+func (s Secret) String() string {
+	if !s.Valid {
+		return "null"
+	}
+	return SecretRedacted
+}
+
+// GoString implements fmt.GoStringer. The value is redacted.
+func (s Secret) GoString() string {
+	if !s.Valid {
+		return "null.Secret{}"
+	}
+	return "null.Secret{" + SecretRedacted + "}"
+}
+
+// Format implements fmt.Formatter, so every verb writes the redacted form.
+func (s Secret) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		io.WriteString(f, s.GoString())
+		return
+	}
+	io.WriteString(f, s.String())
+}
+
+// SetValid changes this Secret's value and also sets it to be non-null.
+func (s *Secret) SetValid(v string) {
+	s.secret = v
+	s.Valid = true
+}
+
+// IsZero returns true for null secrets, for potential future omitempty support.
+func (s Secret) IsZero() bool {
+	return !s.Valid
+}
+
+// Scan implements the Scanner interface.
+func (s *Secret) Scan(value interface{}) error {
+	if value == nil {
+		s.secret, s.Valid = "", false
+		return nil
+	}
+	s.Valid = true
+	return convert.ConvertAssign(&s.secret, value)
+}
+
+// Value implements the driver Valuer interface.
+// The clear value is stored, protecting it at rest is up to the database.
+func (s Secret) Value() (driver.Value, error) {
+	if !s.Valid {
+		return nil, nil
+	}
+	return s.secret, nil
+}
+
+// Randomize for sqlboiler
+func (s *Secret) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	str, ok := randomize.FormattedString(nextInt, fieldType)
+	if ok {
+		s.secret = str
+		s.Valid = true
+		return
+	}
+
+	if shouldBeNull {
+		s.secret = ""
+		s.Valid = false
+	} else {
+		s.secret = randomize.Str(nextInt, 1)
+		s.Valid = true
+	}
+}
+
+// MarshalRevealed is like json.Marshal, but writes the clear value of every
+// Secret found in v. Use it only where the clear value is meant to leave the
+// process, e.g. when calling a payment gateway.
+func MarshalRevealed(v interface{}) ([]byte, error) {
+	return json.Marshal(revealSecrets(v))
+}
+
+// SecretEncoder is a json.Encoder that writes the clear value of every Secret,
+// see MarshalRevealed.
+type SecretEncoder struct {
+	*json.Encoder
+}
+
+// NewSecretEncoder returns a new SecretEncoder that writes to w.
+func NewSecretEncoder(w io.Writer) *SecretEncoder {
+	return &SecretEncoder{json.NewEncoder(w)}
+}
+
+// Encode writes the JSON encoding of v with the clear value of every Secret.
+func (e *SecretEncoder) Encode(v interface{}) error {
+	return e.Encoder.Encode(revealSecrets(v))
+}
+
+// revealSecrets returns a copy of v in which every Secret reveals its value.
+// Parts of v that cannot hold a Secret are shared with the copy.
+func revealSecrets(v interface{}) interface{} {
+	if v == nil {
+		return nil
+	}
+	r := revealer{copies: map[revealedPointer]reflect.Value{}}
+	return r.copy(reflect.ValueOf(v)).Interface()
+}
+
+type revealer struct {
+	// copies keeps the copy of every pointer, so cycles are preserved for
+	// json to report instead of recursing forever.
+	copies map[revealedPointer]reflect.Value
+}
+
+// revealedPointer identifies a pointer by its type as well as its address,
+// since a struct and its first field share their address.
+type revealedPointer struct {
+	t reflect.Type
+	p uintptr
+}
+
+func (r *revealer) copy(v reflect.Value) reflect.Value {
+	if !holdsSecret(v.Type()) {
+		return v
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.Type() == secretType {
+			s := v.Interface().(Secret)
+			s.reveal = true
+			return reflect.ValueOf(s)
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(v)
+		for i := 0; i < v.NumField(); i++ {
+			if f := out.Field(i); f.CanSet() {
+				f.Set(r.copy(v.Field(i)))
+			}
+		}
+		return out
+	case reflect.Ptr:
+		if v.IsNil() {
+			return v
+		}
+		key := revealedPointer{v.Type(), v.Pointer()}
+		if c, ok := r.copies[key]; ok {
+			return c
+		}
+		out := reflect.New(v.Type().Elem())
+		r.copies[key] = out
+		out.Elem().Set(r.copy(v.Elem()))
+		return out
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.New(v.Type()).Elem()
+		out.Set(r.copy(v.Elem()))
+		return out
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(r.copy(v.Index(i)))
+		}
+		return out
+	case reflect.Array:
+		out := reflect.New(v.Type()).Elem()
+		for i := 0; i < v.Len(); i++ {
+			out.Index(i).Set(r.copy(v.Index(i)))
+		}
+		return out
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+		out := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			out.SetMapIndex(iter.Key(), r.copy(iter.Value()))
+		}
+		return out
+	}
+	return v
+}
+
+var (
+	secretType = reflect.TypeOf(Secret{})
+
+	holdsSecretCache sync.Map
+)
+
+// holdsSecret reports whether a value of type t may contain a Secret.
+func holdsSecret(t reflect.Type) bool {
+	if cached, ok := holdsSecretCache.Load(t); ok {
+		return cached.(bool)
+	}
+	result := walkHoldsSecret(t, map[reflect.Type]bool{})
+	holdsSecretCache.Store(t, result)
+	return result
+}
+
+// walkHoldsSecret implements holdsSecret. A type met again on the way down
+// adds nothing, its other fields are walked at its first visit.
+func walkHoldsSecret(t reflect.Type, visiting map[reflect.Type]bool) bool {
+	if visiting[t] {
+		return false
+	}
+	visiting[t] = true
+	switch t.Kind() {
+	case reflect.Interface:
+		return true
+	case reflect.Struct:
+		if t == secretType {
+			return true
+		}
+		for i := 0; i < t.NumField(); i++ {
+			if t.Field(i).PkgPath == "" && walkHoldsSecret(t.Field(i).Type, visiting) {
+				return true
+			}
+		}
+	case reflect.Ptr, reflect.Slice, reflect.Array, reflect.Map:
+		return walkHoldsSecret(t.Elem(), visiting)
+	}
+	return false
+}
@@ -0,0 +1,228 @@
+package null
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+const secretValue = "s3cr3t"
+
+type secretInStruct struct {
+	CVV   Secret `json:"cvv"`
+	Other string `json:"other"`
+}
+
+type secretNode struct {
+	Next  *secretNode            `json:"next,omitempty"`
+	Key   Secret                 `json:"key"`
+	Extra map[string]interface{} `json:"extra,omitempty"`
+	At    time.Time              `json:"at"`
+}
+
+func TestSecretFrom(t *testing.T) {
+	s := SecretFrom(secretValue)
+	assertSecret(t, s, "SecretFrom()")
+
+	zero := SecretFrom("")
+	if !zero.Valid {
+		t.Error("SecretFrom(0)", "is invalid, but should be valid")
+	}
+}
+
+func TestSecretFromPtr(t *testing.T) {
+	v := secretValue
+	assertSecret(t, SecretFromPtr(&v), "SecretFromPtr()")
+
+	null := SecretFromPtr(nil)
+	if null.Valid {
+		t.Error("SecretFromPtr(nil)", "is valid, but should be invalid")
+	}
+}
+
+func TestSecretRedaction(t *testing.T) {
+	s := SecretFrom(secretValue)
+	for _, format := range []string{"%v", "%+v", "%#v", "%s", "%q", "%x", "%X", "%d", "%10s"} {
+		out := fmt.Sprintf(format, s)
+		if strings.Contains(out, secretValue) || strings.Contains(out, fmt.Sprintf("%x", secretValue)) {
+			t.Errorf("%s leaks the secret: %s", format, out)
+		}
+		out = fmt.Sprintf(format, secretInStruct{CVV: s})
+		if strings.Contains(out, secretValue) || strings.Contains(out, fmt.Sprintf("%x", secretValue)) {
+			t.Errorf("%s of a struct leaks the secret: %s", format, out)
+		}
+	}
+	if out := fmt.Sprintf("%#v", s); out != "null.Secret{[REDACTED]}" {
+		t.Errorf("%%#v got = %s", out)
+	}
+	if out := fmt.Sprint(NewSecret("", false)); out != "null" {
+		t.Errorf("%%v of null got = %s", out)
+	}
+
+	data, err := json.Marshal(secretInStruct{CVV: s})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"cvv":"[REDACTED]","other":""}`, "redacted json")
+
+	data, err = json.Marshal(secretInStruct{})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"cvv":null,"other":""}`, "null json")
+
+	data, err = s.MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, SecretRedacted, "redacted text")
+
+	data, err = NewSecret("", false).MarshalText()
+	maybePanic(err)
+	assertJSONEquals(t, data, "", "null text")
+}
+
+func TestUnmarshalSecret(t *testing.T) {
+	var s Secret
+	maybePanic(json.Unmarshal([]byte(`"`+secretValue+`"`), &s))
+	assertSecret(t, s, "UnmarshalJSON()")
+
+	maybePanic(json.Unmarshal(nullJSON, &s))
+	if s.Valid {
+		t.Error("null json should give null Secret")
+	}
+
+	if err := json.Unmarshal([]byte(`"[REDACTED]"`), &s); !errors.Is(err, ErrRedactedSecret) {
+		t.Errorf("UnmarshalJSON() error = %v, want %v", err, ErrRedactedSecret)
+	}
+	if err := json.Unmarshal(boolJSON, &s); err == nil || s.Valid {
+		t.Error("bool json should fail")
+	}
+
+	maybePanic(s.UnmarshalText([]byte(secretValue)))
+	assertSecret(t, s, "UnmarshalText()")
+	maybePanic(s.UnmarshalText([]byte("")))
+	if s.Valid {
+		t.Error("empty text should give null Secret")
+	}
+	if err := s.UnmarshalText([]byte(SecretRedacted)); !errors.Is(err, ErrRedactedSecret) {
+		t.Errorf("UnmarshalText() error = %v, want %v", err, ErrRedactedSecret)
+	}
+}
+
+func TestSecretScanValue(t *testing.T) {
+	var s Secret
+	maybePanic(s.Scan(secretValue))
+	assertSecret(t, s, "Scan()")
+
+	v, err := s.Value()
+	maybePanic(err)
+	if v != secretValue {
+		t.Errorf("Value() got = %v, want %s", v, secretValue)
+	}
+
+	maybePanic(s.Scan(nil))
+	if s.Valid {
+		t.Error("Scan(nil) should give null Secret")
+	}
+	v, err = s.Value()
+	maybePanic(err)
+	if v != nil {
+		t.Errorf("Value() of null got = %v", v)
+	}
+}
+
+func TestSecretSetValid(t *testing.T) {
+	var s Secret
+	s.SetValid(secretValue)
+	assertSecret(t, s, "SetValid()")
+	if s.IsZero() {
+		t.Error("IsZero() should be false")
+	}
+	if !NewSecret("", false).IsZero() {
+		t.Error("IsZero() should be true")
+	}
+}
+
+func TestMarshalRevealed(t *testing.T) {
+	at := time.Date(2025, 9, 1, 0, 0, 0, 0, time.UTC)
+	v := &secretNode{
+		Key: SecretFrom("a"),
+		At:  at,
+		Next: &secretNode{
+			Key:   SecretFrom("b"),
+			Extra: map[string]interface{}{"pin": SecretFrom("c"), "list": []Secret{SecretFrom("d")}},
+			At:    at,
+		},
+	}
+	data, err := MarshalRevealed(v)
+	maybePanic(err)
+	want := `{"next":{"key":"b","extra":{"list":["d"],"pin":"c"},"at":"2025-09-01T00:00:00Z"},"key":"a","at":"2025-09-01T00:00:00Z"}`
+	assertJSONEquals(t, data, want, "revealed json")
+
+	data, err = json.Marshal(v)
+	maybePanic(err)
+	if strings.Contains(string(data), `"a"`) {
+		t.Errorf("json.Marshal after MarshalRevealed leaks the secret: %s", data)
+	}
+
+	data, err = MarshalRevealed(secretInStruct{CVV: SecretFrom(secretValue), Other: "x"})
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"cvv":"s3cr3t","other":"x"}`, "revealed struct")
+
+	data, err = MarshalRevealed(nil)
+	maybePanic(err)
+	assertJSONEquals(t, data, `null`, "revealed nil")
+}
+
+func TestMarshalRevealedCycle(t *testing.T) {
+	v := &secretNode{Key: SecretFrom("a")}
+	v.Next = v
+	if _, err := MarshalRevealed(v); err == nil {
+		t.Error("MarshalRevealed() of a cycle should fail")
+	}
+}
+
+func TestMarshalRevealedAliasedPointers(t *testing.T) {
+	node := &secretInStruct{CVV: SecretFrom(secretValue), Other: "x"}
+	v := struct {
+		Node *secretInStruct `json:"node"`
+		CVV  *Secret         `json:"cvv"`
+	}{node, &node.CVV}
+
+	data, err := MarshalRevealed(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, `{"node":{"cvv":"s3cr3t","other":"x"},"cvv":"s3cr3t"}`, "revealed aliases")
+
+	var buf bytes.Buffer
+	maybePanic(NewSecretEncoder(&buf).Encode(v))
+	assertJSONEquals(t, buf.Bytes(), string(data)+"\n", "encoder aliases")
+}
+
+func TestSecretRevealNull(t *testing.T) {
+	if got := NewSecret("x", false).Reveal(); got != "" {
+		t.Errorf("Reveal() of null got = %q", got)
+	}
+	s := SecretFrom(secretValue)
+	maybePanic(s.UnmarshalText([]byte("")))
+	if s.Valid || s.Reveal() != "" {
+		t.Errorf("UnmarshalText(\"\") left %v, %q", s.Valid, s.Reveal())
+	}
+	s.Valid = true
+	if s.Reveal() != "" {
+		t.Errorf("UnmarshalText(\"\") kept the old secret %q", s.Reveal())
+	}
+}
+
+func TestSecretEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	maybePanic(NewSecretEncoder(&buf).Encode(secretInStruct{CVV: SecretFrom(secretValue)}))
+	assertJSONEquals(t, buf.Bytes(), "{\"cvv\":\"s3cr3t\",\"other\":\"\"}\n", "encoder")
+}
+
+func assertSecret(t *testing.T, s Secret, from string) {
+	if s.Reveal() != secretValue {
+		t.Errorf("bad %s secret: %s ≠ %s\n", from, s.Reveal(), secretValue)
+	}
+	if !s.Valid {
+		t.Error(from, "is invalid, but should be valid")
+	}
+}
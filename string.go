@@ -94,6 +94,23 @@ func (s String) Ptr() *string {
 	return &s.String
 }
 
+// ValueOr returns this String's value, or def if this String is null.
+func (s String) ValueOr(def string) string {
+	if !s.Valid {
+		return def
+	}
+	return s.String
+}
+
+// ValueOrZero returns this String's value, or the zero value of string if this
+// String is null.
+func (s String) ValueOrZero() string {
+	if !s.Valid {
+		return ""
+	}
+	return s.String
+}
+
 // IsZero returns true for null strings, for potential future omitempty support.
 func (s String) IsZero() bool {
 	return !s.Valid
@@ -95,6 +95,23 @@ func (t Time) Ptr() *time.Time {
 	return &t.Time
 }
 
+// ValueOr returns this Time's value, or def if this Time is null.
+func (t Time) ValueOr(def time.Time) time.Time {
+	if !t.Valid {
+		return def
+	}
+	return t.Time
+}
+
+// ValueOrZero returns this Time's value, or the zero value of time.Time if this
+// Time is null.
+func (t Time) ValueOrZero() time.Time {
+	if !t.Valid {
+		return time.Time{}
+	}
+	return t.Time
+}
+
 // IsZero returns true for an invalid Time's value, for potential future omitempty support.
 func (t Time) IsZero() bool {
 	return !t.Valid
@@ -0,0 +1,252 @@
+package null
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+)
+
+// vars
+var (
+	ErrTrackSentinel  = errors.New("missing sentinel in track data")
+	ErrTrackSeparator = errors.New("missing field separator in track data")
+	ErrTrackCharacter = errors.New("invalid character in track data")
+	ErrTrackLength    = errors.New("invalid length of track data")
+	ErrTrackLRC       = errors.New("invalid LRC of track data")
+	ErrTrackFormat    = errors.New("unknown format code of track data")
+)
+
+const (
+	track1MaxLength = 79
+	track2MaxLength = 40
+
+	track1NameMin = 2
+	track1NameMax = 26
+)
+
+// TrackData is the content of an ISO 7813 magnetic stripe track.
+// Fields not present on the track are null.
+type TrackData struct {
+	// Track is 1 or 2.
+	Track int
+	PAN   PAN
+	// Name is the cardholder name, present on track 1 only.
+	Name          String
+	Expiry        CardDate
+	ServiceCode   String
+	Discretionary String
+}
+
+// TrackError describes why track data could not be parsed.
+// It unwraps to one of the ErrTrack* variables, or to the error of the
+// offending field, e.g. a *CardDateError or ErrInvalidPANLuhn.
+type TrackError struct {
+	// Track is 1 or 2.
+	Track int
+	// Field names the offending field: pan, name, expiry, service code,
+	// or is empty if the track as a whole is at fault.
+	Field string
+	// Offset is the byte offset of the offending part of the input, or -1.
+	Offset int
+	// Err is the underlying cause.
+	Err error
+}
+
+// Error implements error.
+func (e *TrackError) Error() string {
+	msg := "null: cannot parse track " + strconv.Itoa(e.Track)
+	if e.Field != "" {
+		msg += " " + e.Field
+	}
+	msg += ": " + e.Err.Error()
+	if e.Offset >= 0 {
+		msg += " (at offset " + strconv.Itoa(e.Offset) + ")"
+	}
+	return msg
+}
+
+// Unwrap returns the underlying cause.
+func (e *TrackError) Unwrap() error {
+	return e.Err
+}
+
+// ParseTrack parses track 1 or track 2 data, telling them apart by the start
+// sentinel or, without sentinels, by the field separator.
+func ParseTrack(s string) (TrackData, error) {
+	if strings.HasPrefix(s, "%") || !strings.HasPrefix(s, ";") && strings.Contains(s, "^") {
+		return ParseTrack1(s)
+	}
+	return ParseTrack2(s)
+}
+
+// ParseTrack1 parses ISO 7813 track 1 data:
+// %B PAN ^ NAME ^ YYMM SERVICE DISCRETIONARY ? LRC.
+// The sentinels may be left out together, the LRC is checked if present.
+func ParseTrack1(s string) (TrackData, error) {
+	t := trackScanner{track: 1, input: s}
+	if err := t.frame('%', 0x20, 0x3f, track1MaxLength); err != nil {
+		return TrackData{}, err
+	}
+	if !strings.HasPrefix(t.data, "B") {
+		return TrackData{}, t.errorAt("", 0, ErrTrackFormat)
+	}
+	t.pos = 1
+
+	d := TrackData{Track: 1}
+	pan, err := t.field("pan", '^')
+	if err != nil {
+		return TrackData{}, err
+	}
+	if d.PAN, err = PANFromString(pan); err != nil {
+		return TrackData{}, t.errorAt("pan", t.pos-len(pan)-1, err)
+	}
+
+	name, err := t.field("name", '^')
+	if err != nil {
+		return TrackData{}, err
+	}
+	if len(name) < track1NameMin || len(name) > track1NameMax {
+		return TrackData{}, t.errorAt("name", t.pos-len(name)-1, ErrTrackLength)
+	}
+	if name = strings.TrimSpace(name); name != "" {
+		d.Name = StringFrom(name)
+	}
+
+	return d, t.tail(&d, '^')
+}
+
+// ParseTrack2 parses ISO 7813 track 2 data:
+// ;PAN = YYMM SERVICE DISCRETIONARY ? LRC.
+// The sentinels may be left out together, the LRC is checked if present.
+func ParseTrack2(s string) (TrackData, error) {
+	t := trackScanner{track: 2, input: s}
+	if err := t.frame(';', 0x30, 0x0f, track2MaxLength); err != nil {
+		return TrackData{}, err
+	}
+
+	d := TrackData{Track: 2}
+	pan, err := t.field("pan", '=')
+	if err != nil {
+		return TrackData{}, err
+	}
+	if d.PAN, err = PANFromString(pan); err != nil {
+		return TrackData{}, t.errorAt("pan", t.pos-len(pan)-1, err)
+	}
+
+	return d, t.tail(&d, '=')
+}
+
+// trackScanner walks the data between the sentinels of a track.
+type trackScanner struct {
+	track int
+	input string
+	// data is input without sentinels and LRC, starting at offset base.
+	data string
+	base int
+	pos  int
+}
+
+// frame checks the length, the character set, the sentinels and the LRC of
+// the track. Characters are encoded as c-charBase and use the bits of mask.
+func (t *trackScanner) frame(start byte, charBase, mask byte, maxLength int) error {
+	s := t.input
+	if len(s) == 0 || len(s) > maxLength {
+		return t.inputError(-1, ErrTrackLength)
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < charBase || s[i]-charBase > mask {
+			return t.inputError(i, ErrTrackCharacter)
+		}
+	}
+	if s[0] != start {
+		if strings.IndexByte(s, '?') >= 0 {
+			return t.inputError(0, ErrTrackSentinel)
+		}
+		t.data = s
+		return nil
+	}
+
+	end := strings.IndexByte(s, '?')
+	switch {
+	case end < 0:
+		return t.inputError(len(s), ErrTrackSentinel)
+	case end == len(s)-2:
+		var lrc byte
+		for i := 0; i <= end; i++ {
+			lrc ^= s[i] - charBase
+		}
+		if lrc&mask != s[end+1]-charBase {
+			return t.inputError(end+1, ErrTrackLRC)
+		}
+	case end != len(s)-1:
+		return t.inputError(end+1, ErrTrackLength)
+	}
+	t.data, t.base = s[1:end], 1
+	return nil
+}
+
+// field returns the data up to the next separator and moves past it.
+func (t *trackScanner) field(name string, sep byte) (string, error) {
+	i := strings.IndexByte(t.data[t.pos:], sep)
+	if i < 0 {
+		return "", t.errorAt(name, len(t.data), ErrTrackSeparator)
+	}
+	f := t.data[t.pos : t.pos+i]
+	t.pos += i + 1
+	return f, nil
+}
+
+// tail parses the expiry date, the service code and the discretionary data.
+// An absent expiry date or service code is replaced by the separator.
+func (t *trackScanner) tail(d *TrackData, sep byte) error {
+	rest := t.data[t.pos:]
+	switch {
+	case strings.HasPrefix(rest, string(sep)):
+		t.pos++
+	case len(rest) < 4:
+		return t.errorAt("expiry", t.pos, ErrTrackLength)
+	default:
+		exp, err := CardDateFromYYMM(rest[:4])
+		if err != nil {
+			return t.errorAt("expiry", t.pos, err)
+		}
+		d.Expiry = exp
+		t.pos += 4
+	}
+
+	rest = t.data[t.pos:]
+	switch {
+	case strings.HasPrefix(rest, string(sep)):
+		t.pos++
+	case len(rest) < 3:
+		return t.errorAt("service code", t.pos, ErrTrackLength)
+	default:
+		for i := 0; i < 3; i++ {
+			if !isDigit(rest[i]) {
+				return t.errorAt("service code", t.pos+i, ErrTrackCharacter)
+			}
+		}
+		d.ServiceCode = StringFrom(rest[:3])
+		t.pos += 3
+	}
+
+	if rest = t.data[t.pos:]; rest != "" {
+		d.Discretionary = StringFrom(rest)
+	}
+	return nil
+}
+
+// errorAt creates a *TrackError at the position pos of t.data.
+func (t *trackScanner) errorAt(field string, pos int, err error) *TrackError {
+	return t.inputError(pos+t.base, err).withField(field)
+}
+
+// inputError creates a *TrackError at the position pos of the input.
+func (t *trackScanner) inputError(pos int, err error) *TrackError {
+	return &TrackError{Track: t.track, Offset: pos, Err: err}
+}
+
+func (e *TrackError) withField(field string) *TrackError {
+	e.Field = field
+	return e
+}
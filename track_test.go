@@ -0,0 +1,147 @@
+package null
+
+import (
+	"errors"
+	"testing"
+)
+
+const (
+	track1Sample = "%B4111111111111111^DOE/JOHN^2512101000000000000000000000000?+"
+	track2Sample = ";4111111111111111=25121010000000000000?8"
+)
+
+func TestParseTrack1(t *testing.T) {
+	d, err := ParseTrack1(track1Sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Track != 1 {
+		t.Errorf("Track got = %d, want 1", d.Track)
+	}
+	if d.PAN.Reveal() != panVisa {
+		t.Errorf("PAN got = %s, want %s", d.PAN.Reveal(), panVisa)
+	}
+	assertNullString(t, d.Name, "DOE/JOHN")
+	if d.Expiry.String() != "12/25" {
+		t.Errorf("Expiry got = %s, want 12/25", d.Expiry.String())
+	}
+	assertNullString(t, d.ServiceCode, "101")
+	assertNullString(t, d.Discretionary, "000000000000000000000000")
+}
+
+func TestParseTrack2(t *testing.T) {
+	d, err := ParseTrack2(track2Sample)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Track != 2 {
+		t.Errorf("Track got = %d, want 2", d.Track)
+	}
+	if d.PAN.Reveal() != panVisa {
+		t.Errorf("PAN got = %s, want %s", d.PAN.Reveal(), panVisa)
+	}
+	if d.Name.Valid {
+		t.Error("Name should be null on track 2")
+	}
+	if d.Expiry.String() != "12/25" {
+		t.Errorf("Expiry got = %s, want 12/25", d.Expiry.String())
+	}
+	assertNullString(t, d.ServiceCode, "101")
+	assertNullString(t, d.Discretionary, "0000000000000")
+}
+
+func TestParseTrackOptionalParts(t *testing.T) {
+	tests := []struct {
+		name          string
+		track         string
+		expiry        bool
+		serviceCode   bool
+		discretionary bool
+	}{
+		{name: "Track 2 without sentinels", track: "4111111111111111=2512101", expiry: true, serviceCode: true},
+		{name: "Track 2 without LRC", track: ";4111111111111111=2512101123?", expiry: true, serviceCode: true, discretionary: true},
+		{name: "Track 2 without expiry", track: ";4111111111111111==101?", serviceCode: true},
+		{name: "Track 2 without expiry and service code", track: ";4111111111111111===?"},
+		{name: "Track 1 without sentinels", track: "B4111111111111111^DOE/JOHN^^^12", discretionary: true},
+		{name: "Track 1 without LRC", track: "%B4111111111111111^DOE/JOHN^2512^?", expiry: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d, err := ParseTrack(test.track)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if d.PAN.Reveal() != panVisa {
+				t.Errorf("PAN got = %s, want %s", d.PAN.Reveal(), panVisa)
+			}
+			if d.Expiry.Valid != test.expiry {
+				t.Errorf("Expiry valid got = %v, want %v", d.Expiry.Valid, test.expiry)
+			}
+			if d.ServiceCode.Valid != test.serviceCode {
+				t.Errorf("ServiceCode valid got = %v, want %v", d.ServiceCode.Valid, test.serviceCode)
+			}
+			if d.Discretionary.Valid != test.discretionary {
+				t.Errorf("Discretionary valid got = %v, want %v", d.Discretionary.Valid, test.discretionary)
+			}
+		})
+	}
+}
+
+func TestParseTrackErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		track  string
+		field  string
+		offset int
+		err    error
+	}{
+		{name: "Bad LRC", track: ";4111111111111111=25121010000000000000?9", offset: 39, err: ErrTrackLRC},
+		{name: "Missing end sentinel", track: ";4111111111111111=2512101", offset: 25, err: ErrTrackSentinel},
+		{name: "End sentinel without start sentinel", track: "4111111111111111=2512101?", offset: 0, err: ErrTrackSentinel},
+		{name: "Data after LRC", track: ";4111111111111111=2512101?88", offset: 26, err: ErrTrackLength},
+		{name: "Letter on track 2", track: ";4111111111111111=2512A01?", offset: 22, err: ErrTrackCharacter},
+		{name: "Track 2 too long", track: ";41111111111111111111111111111111111111111?", offset: -1, err: ErrTrackLength},
+		{name: "Missing separator", track: ";4111111111111111?", field: "pan", offset: 17, err: ErrTrackSeparator},
+		{name: "Invalid PAN", track: ";4111111111111112=2512101?", field: "pan", offset: 1, err: ErrInvalidPANLuhn},
+		{name: "Invalid expiry month", track: ";4111111111111111=2513101?", field: "expiry", offset: 18, err: ErrInvalidMonth},
+		{name: "Short expiry", track: ";4111111111111111=25?", field: "expiry", offset: 18, err: ErrTrackLength},
+		{name: "Bad service code", track: ";4111111111111111=25121:1?", field: "service code", offset: 23, err: ErrTrackCharacter},
+		{name: "Track 1 format code", track: "%A4111111111111111^DOE/JOHN^2512101?", offset: 1, err: ErrTrackFormat},
+		{name: "Track 1 short name", track: "%B4111111111111111^D^2512101?", field: "name", offset: 19, err: ErrTrackLength},
+		{name: "Track 1 missing name separator", track: "%B4111111111111111^DOE/JOHN?", field: "name", offset: 27, err: ErrTrackSeparator},
+		{name: "Track 1 lower case", track: "%B4111111111111111^doe/john^2512101?", offset: 19, err: ErrTrackCharacter},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := ParseTrack(test.track)
+			if !errors.Is(err, test.err) {
+				t.Fatalf("ParseTrack() error = %v, want %v", err, test.err)
+			}
+			var e *TrackError
+			if !errors.As(err, &e) {
+				t.Fatalf("error should be *TrackError, instead of %T", err)
+			}
+			if e.Field != test.field {
+				t.Errorf("Field got = %q, want %q", e.Field, test.field)
+			}
+			if e.Offset != test.offset {
+				t.Errorf("Offset got = %d, want %d", e.Offset, test.offset)
+			}
+		})
+	}
+}
+
+func TestTrackError_Error(t *testing.T) {
+	_, err := ParseTrack2(";4111111111111111=2513101?")
+	want := `null: cannot parse track 2 expiry: null: cannot parse card date "2513" as YYMM: invalid month in card date (month at offset 2) (at offset 18)`
+	if err.Error() != want {
+		t.Errorf("Error() got = %s, want %s", err.Error(), want)
+	}
+}
+
+func assertNullString(t *testing.T, s String, want string) {
+	t.Helper()
+	if !s.Valid || s.String != want {
+		t.Errorf("String got = %#v, want %s", s, want)
+	}
+}
@@ -99,6 +99,23 @@ func (u Uint) Ptr() *uint {
 	return &u.Uint
 }
 
+// ValueOr returns this Uint's value, or def if this Uint is null.
+func (u Uint) ValueOr(def uint) uint {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint
+}
+
+// ValueOrZero returns this Uint's value, or the zero value of uint if this
+// Uint is null.
+func (u Uint) ValueOrZero() uint {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint
+}
+
 // IsZero returns true for invalid Uints, for future omitempty support (Go 1.4?)
 func (u Uint) IsZero() bool {
 	return !u.Valid
@@ -132,3 +149,93 @@ func (u *Uint) Randomize(nextInt func() int64, fieldType string, shouldBeNull bo
 		u.Valid = true
 	}
 }
+
+// Add returns u + v, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (u Uint) Add(v Uint) Uint {
+	x, ok, _ := binary(u.Uint, u.Valid, v.Uint, v.Valid, add[uint])
+	return NewUint(x, ok)
+}
+
+// Sub returns u - v, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (u Uint) Sub(v Uint) Uint {
+	x, ok, _ := binary(u.Uint, u.Valid, v.Uint, v.Valid, sub[uint])
+	return NewUint(x, ok)
+}
+
+// Mul returns u * v, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (u Uint) Mul(v Uint) Uint {
+	x, ok, _ := binary(u.Uint, u.Valid, v.Uint, v.Valid, mul[uint])
+	return NewUint(x, ok)
+}
+
+// Div returns u / v, or null if either is null.
+// It is null as well if v is zero, see DivChecked.
+func (u Uint) Div(v Uint) Uint {
+	x, ok, _ := binary(u.Uint, u.Valid, v.Uint, v.Valid, div[uint])
+	return NewUint(x, ok)
+}
+
+// Mod returns u % v, or null if either is null.
+// It is null as well if v is zero, see ModChecked.
+func (u Uint) Mod(v Uint) Uint {
+	x, ok, _ := binary(u.Uint, u.Valid, v.Uint, v.Valid, mod[uint])
+	return NewUint(x, ok)
+}
+
+// Neg returns -u, or null if u is null.
+// It wraps around unless u is zero, see NegChecked.
+func (u Uint) Neg() Uint {
+	x, ok, _ := unary(u.Uint, u.Valid, neg[uint])
+	return NewUint(x, ok)
+}
+
+// Abs returns u, or null if u is null.
+func (u Uint) Abs() Uint {
+	x, ok, _ := unary(u.Uint, u.Valid, abs[uint])
+	return NewUint(x, ok)
+}
+
+// AddChecked returns u + v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint.
+func (u Uint) AddChecked(v Uint) (Uint, error) {
+	x, ok, err := binary(u.Uint, u.Valid, v.Uint, v.Valid, addChecked[uint])
+	return NewUint(x, ok), err
+}
+
+// SubChecked returns u - v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint.
+func (u Uint) SubChecked(v Uint) (Uint, error) {
+	x, ok, err := binary(u.Uint, u.Valid, v.Uint, v.Valid, subChecked[uint])
+	return NewUint(x, ok), err
+}
+
+// MulChecked returns u * v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint.
+func (u Uint) MulChecked(v Uint) (Uint, error) {
+	x, ok, err := binary(u.Uint, u.Valid, v.Uint, v.Valid, mulChecked[uint])
+	return NewUint(x, ok), err
+}
+
+// DivChecked returns u / v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint) DivChecked(v Uint) (Uint, error) {
+	x, ok, err := binary(u.Uint, u.Valid, v.Uint, v.Valid, divChecked[uint])
+	return NewUint(x, ok), err
+}
+
+// ModChecked returns u % v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint) ModChecked(v Uint) (Uint, error) {
+	x, ok, err := binary(u.Uint, u.Valid, v.Uint, v.Valid, modChecked[uint])
+	return NewUint(x, ok), err
+}
+
+// NegChecked returns -u, or null if u is null.
+// It fails with ErrOverflow unless u is zero.
+func (u Uint) NegChecked() (Uint, error) {
+	x, ok, err := unary(u.Uint, u.Valid, negChecked[uint])
+	return NewUint(x, ok), err
+}
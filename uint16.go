@@ -105,6 +105,23 @@ func (u Uint16) Ptr() *uint16 {
 	return &u.Uint16
 }
 
+// ValueOr returns this Uint16's value, or def if this Uint16 is null.
+func (u Uint16) ValueOr(def uint16) uint16 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint16
+}
+
+// ValueOrZero returns this Uint16's value, or the zero value of uint16 if this
+// Uint16 is null.
+func (u Uint16) ValueOrZero() uint16 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint16
+}
+
 // IsZero returns true for invalid Uint16's, for future omitempty support (Go 1.4?)
 func (u Uint16) IsZero() bool {
 	return !u.Valid
@@ -138,3 +155,93 @@ func (u *Uint16) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		u.Valid = true
 	}
 }
+
+// Add returns u + v, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (u Uint16) Add(v Uint16) Uint16 {
+	x, ok, _ := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, add[uint16])
+	return NewUint16(x, ok)
+}
+
+// Sub returns u - v, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (u Uint16) Sub(v Uint16) Uint16 {
+	x, ok, _ := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, sub[uint16])
+	return NewUint16(x, ok)
+}
+
+// Mul returns u * v, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (u Uint16) Mul(v Uint16) Uint16 {
+	x, ok, _ := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, mul[uint16])
+	return NewUint16(x, ok)
+}
+
+// Div returns u / v, or null if either is null.
+// It is null as well if v is zero, see DivChecked.
+func (u Uint16) Div(v Uint16) Uint16 {
+	x, ok, _ := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, div[uint16])
+	return NewUint16(x, ok)
+}
+
+// Mod returns u % v, or null if either is null.
+// It is null as well if v is zero, see ModChecked.
+func (u Uint16) Mod(v Uint16) Uint16 {
+	x, ok, _ := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, mod[uint16])
+	return NewUint16(x, ok)
+}
+
+// Neg returns -u, or null if u is null.
+// It wraps around unless u is zero, see NegChecked.
+func (u Uint16) Neg() Uint16 {
+	x, ok, _ := unary(u.Uint16, u.Valid, neg[uint16])
+	return NewUint16(x, ok)
+}
+
+// Abs returns u, or null if u is null.
+func (u Uint16) Abs() Uint16 {
+	x, ok, _ := unary(u.Uint16, u.Valid, abs[uint16])
+	return NewUint16(x, ok)
+}
+
+// AddChecked returns u + v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint16.
+func (u Uint16) AddChecked(v Uint16) (Uint16, error) {
+	x, ok, err := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, addChecked[uint16])
+	return NewUint16(x, ok), err
+}
+
+// SubChecked returns u - v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint16.
+func (u Uint16) SubChecked(v Uint16) (Uint16, error) {
+	x, ok, err := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, subChecked[uint16])
+	return NewUint16(x, ok), err
+}
+
+// MulChecked returns u * v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint16.
+func (u Uint16) MulChecked(v Uint16) (Uint16, error) {
+	x, ok, err := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, mulChecked[uint16])
+	return NewUint16(x, ok), err
+}
+
+// DivChecked returns u / v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint16) DivChecked(v Uint16) (Uint16, error) {
+	x, ok, err := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, divChecked[uint16])
+	return NewUint16(x, ok), err
+}
+
+// ModChecked returns u % v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint16) ModChecked(v Uint16) (Uint16, error) {
+	x, ok, err := binary(u.Uint16, u.Valid, v.Uint16, v.Valid, modChecked[uint16])
+	return NewUint16(x, ok), err
+}
+
+// NegChecked returns -u, or null if u is null.
+// It fails with ErrOverflow unless u is zero.
+func (u Uint16) NegChecked() (Uint16, error) {
+	x, ok, err := unary(u.Uint16, u.Valid, negChecked[uint16])
+	return NewUint16(x, ok), err
+}
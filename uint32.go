@@ -105,6 +105,23 @@ func (u Uint32) Ptr() *uint32 {
 	return &u.Uint32
 }
 
+// ValueOr returns this Uint32's value, or def if this Uint32 is null.
+func (u Uint32) ValueOr(def uint32) uint32 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint32
+}
+
+// ValueOrZero returns this Uint32's value, or the zero value of uint32 if this
+// Uint32 is null.
+func (u Uint32) ValueOrZero() uint32 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint32
+}
+
 // IsZero returns true for invalid Uint32's, for future omitempty support (Go 1.4?)
 func (u Uint32) IsZero() bool {
 	return !u.Valid
@@ -138,3 +155,93 @@ func (u *Uint32) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		u.Valid = true
 	}
 }
+
+// Add returns u + v, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (u Uint32) Add(v Uint32) Uint32 {
+	x, ok, _ := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, add[uint32])
+	return NewUint32(x, ok)
+}
+
+// Sub returns u - v, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (u Uint32) Sub(v Uint32) Uint32 {
+	x, ok, _ := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, sub[uint32])
+	return NewUint32(x, ok)
+}
+
+// Mul returns u * v, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (u Uint32) Mul(v Uint32) Uint32 {
+	x, ok, _ := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, mul[uint32])
+	return NewUint32(x, ok)
+}
+
+// Div returns u / v, or null if either is null.
+// It is null as well if v is zero, see DivChecked.
+func (u Uint32) Div(v Uint32) Uint32 {
+	x, ok, _ := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, div[uint32])
+	return NewUint32(x, ok)
+}
+
+// Mod returns u % v, or null if either is null.
+// It is null as well if v is zero, see ModChecked.
+func (u Uint32) Mod(v Uint32) Uint32 {
+	x, ok, _ := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, mod[uint32])
+	return NewUint32(x, ok)
+}
+
+// Neg returns -u, or null if u is null.
+// It wraps around unless u is zero, see NegChecked.
+func (u Uint32) Neg() Uint32 {
+	x, ok, _ := unary(u.Uint32, u.Valid, neg[uint32])
+	return NewUint32(x, ok)
+}
+
+// Abs returns u, or null if u is null.
+func (u Uint32) Abs() Uint32 {
+	x, ok, _ := unary(u.Uint32, u.Valid, abs[uint32])
+	return NewUint32(x, ok)
+}
+
+// AddChecked returns u + v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint32.
+func (u Uint32) AddChecked(v Uint32) (Uint32, error) {
+	x, ok, err := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, addChecked[uint32])
+	return NewUint32(x, ok), err
+}
+
+// SubChecked returns u - v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint32.
+func (u Uint32) SubChecked(v Uint32) (Uint32, error) {
+	x, ok, err := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, subChecked[uint32])
+	return NewUint32(x, ok), err
+}
+
+// MulChecked returns u * v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint32.
+func (u Uint32) MulChecked(v Uint32) (Uint32, error) {
+	x, ok, err := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, mulChecked[uint32])
+	return NewUint32(x, ok), err
+}
+
+// DivChecked returns u / v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint32) DivChecked(v Uint32) (Uint32, error) {
+	x, ok, err := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, divChecked[uint32])
+	return NewUint32(x, ok), err
+}
+
+// ModChecked returns u % v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint32) ModChecked(v Uint32) (Uint32, error) {
+	x, ok, err := binary(u.Uint32, u.Valid, v.Uint32, v.Valid, modChecked[uint32])
+	return NewUint32(x, ok), err
+}
+
+// NegChecked returns -u, or null if u is null.
+// It fails with ErrOverflow unless u is zero.
+func (u Uint32) NegChecked() (Uint32, error) {
+	x, ok, err := unary(u.Uint32, u.Valid, negChecked[uint32])
+	return NewUint32(x, ok), err
+}
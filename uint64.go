@@ -97,6 +97,23 @@ func (u Uint64) Ptr() *uint64 {
 	return &u.Uint64
 }
 
+// ValueOr returns this Uint64's value, or def if this Uint64 is null.
+func (u Uint64) ValueOr(def uint64) uint64 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint64
+}
+
+// ValueOrZero returns this Uint64's value, or the zero value of uint64 if this
+// Uint64 is null.
+func (u Uint64) ValueOrZero() uint64 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint64
+}
+
 // IsZero returns true for invalid Uint64's, for future omitempty support (Go 1.4?)
 func (u Uint64) IsZero() bool {
 	return !u.Valid
@@ -142,3 +159,93 @@ func (u *Uint64) Randomize(nextInt func() int64, fieldType string, shouldBeNull
 		u.Valid = true
 	}
 }
+
+// Add returns u + v, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (u Uint64) Add(v Uint64) Uint64 {
+	x, ok, _ := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, add[uint64])
+	return NewUint64(x, ok)
+}
+
+// Sub returns u - v, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (u Uint64) Sub(v Uint64) Uint64 {
+	x, ok, _ := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, sub[uint64])
+	return NewUint64(x, ok)
+}
+
+// Mul returns u * v, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (u Uint64) Mul(v Uint64) Uint64 {
+	x, ok, _ := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, mul[uint64])
+	return NewUint64(x, ok)
+}
+
+// Div returns u / v, or null if either is null.
+// It is null as well if v is zero, see DivChecked.
+func (u Uint64) Div(v Uint64) Uint64 {
+	x, ok, _ := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, div[uint64])
+	return NewUint64(x, ok)
+}
+
+// Mod returns u % v, or null if either is null.
+// It is null as well if v is zero, see ModChecked.
+func (u Uint64) Mod(v Uint64) Uint64 {
+	x, ok, _ := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, mod[uint64])
+	return NewUint64(x, ok)
+}
+
+// Neg returns -u, or null if u is null.
+// It wraps around unless u is zero, see NegChecked.
+func (u Uint64) Neg() Uint64 {
+	x, ok, _ := unary(u.Uint64, u.Valid, neg[uint64])
+	return NewUint64(x, ok)
+}
+
+// Abs returns u, or null if u is null.
+func (u Uint64) Abs() Uint64 {
+	x, ok, _ := unary(u.Uint64, u.Valid, abs[uint64])
+	return NewUint64(x, ok)
+}
+
+// AddChecked returns u + v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint64.
+func (u Uint64) AddChecked(v Uint64) (Uint64, error) {
+	x, ok, err := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, addChecked[uint64])
+	return NewUint64(x, ok), err
+}
+
+// SubChecked returns u - v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint64.
+func (u Uint64) SubChecked(v Uint64) (Uint64, error) {
+	x, ok, err := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, subChecked[uint64])
+	return NewUint64(x, ok), err
+}
+
+// MulChecked returns u * v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint64.
+func (u Uint64) MulChecked(v Uint64) (Uint64, error) {
+	x, ok, err := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, mulChecked[uint64])
+	return NewUint64(x, ok), err
+}
+
+// DivChecked returns u / v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint64) DivChecked(v Uint64) (Uint64, error) {
+	x, ok, err := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, divChecked[uint64])
+	return NewUint64(x, ok), err
+}
+
+// ModChecked returns u % v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint64) ModChecked(v Uint64) (Uint64, error) {
+	x, ok, err := binary(u.Uint64, u.Valid, v.Uint64, v.Valid, modChecked[uint64])
+	return NewUint64(x, ok), err
+}
+
+// NegChecked returns -u, or null if u is null.
+// It fails with ErrOverflow unless u is zero.
+func (u Uint64) NegChecked() (Uint64, error) {
+	x, ok, err := unary(u.Uint64, u.Valid, negChecked[uint64])
+	return NewUint64(x, ok), err
+}
@@ -105,6 +105,23 @@ func (u Uint8) Ptr() *uint8 {
 	return &u.Uint8
 }
 
+// ValueOr returns this Uint8's value, or def if this Uint8 is null.
+func (u Uint8) ValueOr(def uint8) uint8 {
+	if !u.Valid {
+		return def
+	}
+	return u.Uint8
+}
+
+// ValueOrZero returns this Uint8's value, or the zero value of uint8 if this
+// Uint8 is null.
+func (u Uint8) ValueOrZero() uint8 {
+	if !u.Valid {
+		return 0
+	}
+	return u.Uint8
+}
+
 // IsZero returns true for invalid Uint8's, for future omitempty support (Go 1.4?)
 func (u Uint8) IsZero() bool {
 	return !u.Valid
@@ -138,3 +155,93 @@ func (u *Uint8) Randomize(nextInt func() int64, fieldType string, shouldBeNull b
 		u.Valid = true
 	}
 }
+
+// Add returns u + v, or null if either is null.
+// It wraps around on overflow, see AddChecked.
+func (u Uint8) Add(v Uint8) Uint8 {
+	x, ok, _ := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, add[uint8])
+	return NewUint8(x, ok)
+}
+
+// Sub returns u - v, or null if either is null.
+// It wraps around on overflow, see SubChecked.
+func (u Uint8) Sub(v Uint8) Uint8 {
+	x, ok, _ := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, sub[uint8])
+	return NewUint8(x, ok)
+}
+
+// Mul returns u * v, or null if either is null.
+// It wraps around on overflow, see MulChecked.
+func (u Uint8) Mul(v Uint8) Uint8 {
+	x, ok, _ := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, mul[uint8])
+	return NewUint8(x, ok)
+}
+
+// Div returns u / v, or null if either is null.
+// It is null as well if v is zero, see DivChecked.
+func (u Uint8) Div(v Uint8) Uint8 {
+	x, ok, _ := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, div[uint8])
+	return NewUint8(x, ok)
+}
+
+// Mod returns u % v, or null if either is null.
+// It is null as well if v is zero, see ModChecked.
+func (u Uint8) Mod(v Uint8) Uint8 {
+	x, ok, _ := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, mod[uint8])
+	return NewUint8(x, ok)
+}
+
+// Neg returns -u, or null if u is null.
+// It wraps around unless u is zero, see NegChecked.
+func (u Uint8) Neg() Uint8 {
+	x, ok, _ := unary(u.Uint8, u.Valid, neg[uint8])
+	return NewUint8(x, ok)
+}
+
+// Abs returns u, or null if u is null.
+func (u Uint8) Abs() Uint8 {
+	x, ok, _ := unary(u.Uint8, u.Valid, abs[uint8])
+	return NewUint8(x, ok)
+}
+
+// AddChecked returns u + v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint8.
+func (u Uint8) AddChecked(v Uint8) (Uint8, error) {
+	x, ok, err := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, addChecked[uint8])
+	return NewUint8(x, ok), err
+}
+
+// SubChecked returns u - v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint8.
+func (u Uint8) SubChecked(v Uint8) (Uint8, error) {
+	x, ok, err := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, subChecked[uint8])
+	return NewUint8(x, ok), err
+}
+
+// MulChecked returns u * v, or null if either is null.
+// It fails with ErrOverflow if the result does not fit in an uint8.
+func (u Uint8) MulChecked(v Uint8) (Uint8, error) {
+	x, ok, err := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, mulChecked[uint8])
+	return NewUint8(x, ok), err
+}
+
+// DivChecked returns u / v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint8) DivChecked(v Uint8) (Uint8, error) {
+	x, ok, err := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, divChecked[uint8])
+	return NewUint8(x, ok), err
+}
+
+// ModChecked returns u % v, or null if either is null.
+// It fails with ErrDivisionByZero if v is zero.
+func (u Uint8) ModChecked(v Uint8) (Uint8, error) {
+	x, ok, err := binary(u.Uint8, u.Valid, v.Uint8, v.Valid, modChecked[uint8])
+	return NewUint8(x, ok), err
+}
+
+// NegChecked returns -u, or null if u is null.
+// It fails with ErrOverflow unless u is zero.
+func (u Uint8) NegChecked() (Uint8, error) {
+	x, ok, err := unary(u.Uint8, u.Valid, negChecked[uint8])
+	return NewUint8(x, ok), err
+}
@@ -0,0 +1,301 @@
+package null
+
+import (
+	"bytes"
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/metricsglobal/null/convert"
+	"github.com/volatiletech/randomize"
+)
+
+// Value is a nullable T. It supports SQL, JSON and text serialization for
+// bool, string, []byte, the integer and floating point types and time.Time,
+// and for named types of them such as `type Cents int64`. A T implementing
+// json.Marshaler, encoding.TextMarshaler, sql.Scanner or driver.Valuer is
+// serialized with its own methods. Unlike Bytes, a Value[[]byte] is
+// written to JSON in base64, like encoding/json does.
+//
+// Value converts to and from the concrete types of the package:
+// ValueOf(i) turns an Int64 into a Value[int64], Int64FromPtr(v.Ptr())
+// turns it back.
+type Value[T any] struct {
+	V     T
+	Valid bool
+}
+
+// NewValue creates a new Value.
+func NewValue[T any](v T, valid bool) Value[T] {
+	return Value[T]{
+		V:     v,
+		Valid: valid,
+	}
+}
+
+// ValueFrom creates a new Value that will always be valid.
+func ValueFrom[T any](v T) Value[T] {
+	return NewValue(v, true)
+}
+
+// ValueFromPtr creates a new Value that will be null if v is nil.
+func ValueFromPtr[T any](v *T) Value[T] {
+	if v == nil {
+		var zero T
+		return NewValue(zero, false)
+	}
+	return NewValue(*v, true)
+}
+
+// ValueOf converts n, any type of the package with a Ptr method such as
+// Int64, String or Time, into a Value.
+func ValueOf[T any](n interface{ Ptr() *T }) Value[T] {
+	return ValueFromPtr(n.Ptr())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Value[T]) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, NullBytes) {
+		v.setNull()
+		return nil
+	}
+
+	var x T
+	if err := json.Unmarshal(data, &x); err != nil {
+		return err
+	}
+	v.SetValid(x)
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Value[T]) MarshalJSON() ([]byte, error) {
+	if !v.Valid {
+		return NullBytes, nil
+	}
+	return json.Marshal(v.V)
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+// Empty text gives a null Value.
+func (v *Value[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		v.setNull()
+		return nil
+	}
+
+	var x T
+	var err error
+	if u, ok := any(&x).(encoding.TextUnmarshaler); ok {
+		err = u.UnmarshalText(text)
+	} else {
+		err = parseText(reflect.ValueOf(&x).Elem(), string(text))
+	}
+	if err != nil {
+		v.setNull()
+		return err
+	}
+	v.SetValid(x)
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// A null Value gives empty text.
+func (v Value[T]) MarshalText() ([]byte, error) {
+	if !v.Valid {
+		return []byte{}, nil
+	}
+	if m, ok := any(v.V).(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	return formatText(reflect.ValueOf(v.V))
+}
+
+// SetValid changes this Value's value and also sets it to be non-null.
+func (v *Value[T]) SetValid(x T) {
+	v.V = x
+	v.Valid = true
+}
+
+// Ptr returns a pointer to this Value's value, or a nil pointer if this Value is null.
+func (v Value[T]) Ptr() *T {
+	if !v.Valid {
+		return nil
+	}
+	return &v.V
+}
+
+// ValueOr returns this Value's value, or def if this Value is null.
+func (v Value[T]) ValueOr(def T) T {
+	if !v.Valid {
+		return def
+	}
+	return v.V
+}
+
+// ValueOrZero returns this Value's value, or the zero value of T if this
+// Value is null.
+func (v Value[T]) ValueOrZero() T {
+	if !v.Valid {
+		var zero T
+		return zero
+	}
+	return v.V
+}
+
+// IsZero returns true for a null Value, for potential future omitempty support.
+func (v Value[T]) IsZero() bool {
+	return !v.Valid
+}
+
+// Scan implements the Scanner interface.
+func (v *Value[T]) Scan(value interface{}) error {
+	if value == nil {
+		v.setNull()
+		return nil
+	}
+
+	var x T
+	var err error
+	rv := reflect.ValueOf(&x).Elem()
+	if s, ok := any(&x).(sql.Scanner); ok {
+		err = s.Scan(value)
+	} else if b, ok := value.([]byte); ok && rv.Kind() == reflect.String {
+		rv.SetString(string(b))
+	} else {
+		err = convert.ConvertAssign(&x, value)
+	}
+	if err != nil {
+		v.setNull()
+		return err
+	}
+	v.SetValid(x)
+	return nil
+}
+
+// Value implements the driver Valuer interface.
+func (v Value[T]) Value() (driver.Value, error) {
+	if !v.Valid {
+		return nil, nil
+	}
+	if valuer, ok := any(v.V).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+	return driver.DefaultParameterConverter.ConvertValue(v.V)
+}
+
+// Randomize for sqlboiler
+func (v *Value[T]) Randomize(nextInt func() int64, fieldType string, shouldBeNull bool) {
+	if shouldBeNull {
+		v.setNull()
+		return
+	}
+
+	var x T
+	if r, ok := any(&x).(interface {
+		Randomize(func() int64, string, bool)
+	}); ok {
+		r.Randomize(nextInt, fieldType, false)
+	} else {
+		randomizeValue(reflect.ValueOf(&x).Elem(), nextInt, fieldType)
+	}
+	v.SetValid(x)
+}
+
+func (v *Value[T]) setNull() {
+	var zero T
+	v.V = zero
+	v.Valid = false
+}
+
+var (
+	bytesType = reflect.TypeOf([]byte(nil))
+	timeType  = reflect.TypeOf(time.Time{})
+)
+
+// formatText writes the scalar rv like the concrete types of the package.
+func formatText(rv reflect.Value) ([]byte, error) {
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return []byte(strconv.FormatBool(rv.Bool())), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []byte(strconv.FormatInt(rv.Int(), 10)), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return []byte(strconv.FormatUint(rv.Uint(), 10)), nil
+	case reflect.Float32, reflect.Float64:
+		return []byte(strconv.FormatFloat(rv.Float(), 'f', -1, rv.Type().Bits())), nil
+	case reflect.Slice:
+		if rv.Type().ConvertibleTo(bytesType) {
+			return rv.Convert(bytesType).Bytes(), nil
+		}
+	}
+	return nil, fmt.Errorf("null: cannot marshal %s as text", rv.Type())
+}
+
+// parseText is the inverse of formatText.
+func parseText(rv reflect.Value, s string) error {
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		rv.SetBool(b)
+		return err
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		rv.SetInt(i)
+		return err
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		rv.SetUint(u)
+		return err
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		rv.SetFloat(f)
+		return err
+	case reflect.Slice:
+		if bytesType.ConvertibleTo(rv.Type()) {
+			rv.Set(reflect.ValueOf([]byte(s)).Convert(rv.Type()))
+			return nil
+		}
+	}
+	return fmt.Errorf("null: cannot unmarshal text into %s", rv.Type())
+}
+
+// randomizeValue sets the scalar rv like the Randomize methods of the
+// concrete types of the package.
+func randomizeValue(rv reflect.Value, nextInt func() int64, fieldType string) {
+	switch rv.Kind() {
+	case reflect.String:
+		str, ok := randomize.FormattedString(nextInt, fieldType)
+		if !ok {
+			str = randomize.Str(nextInt, 1)
+		}
+		rv.SetString(str)
+	case reflect.Bool:
+		rv.SetBool(nextInt()%2 == 1)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		rv.SetInt(nextInt() % int64(math.MaxInt64>>uint(64-rv.Type().Bits())))
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		rv.SetUint(uint64(nextInt()) % uint64(math.MaxInt64>>uint(64-rv.Type().Bits())))
+	case reflect.Float32, reflect.Float64:
+		rv.SetFloat(float64(nextInt()%10)/10.0 + float64(nextInt()%10))
+	case reflect.Slice:
+		if bytesType.ConvertibleTo(rv.Type()) {
+			rv.Set(reflect.ValueOf([]byte{byte(nextInt() % 256)}).Convert(rv.Type()))
+		}
+	case reflect.Struct:
+		if rv.Type() == timeType {
+			rv.Set(reflect.ValueOf(randomize.Date(nextInt)))
+		}
+	}
+}
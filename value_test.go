@@ -0,0 +1,194 @@
+package null
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+type cents int64
+
+type currency string
+
+func TestValueJSON(t *testing.T) {
+	assertValueJSON(t, ValueFrom(int64(12345)), "12345")
+	assertValueJSON(t, ValueFrom("test"), `"test"`)
+	assertValueJSON(t, ValueFrom(true), "true")
+	assertValueJSON(t, ValueFrom(1.2345), "1.2345")
+	assertValueJSON(t, ValueFrom(cents(-42)), "-42")
+	assertValueJSON(t, ValueFrom(timeValue), string(timeJSON))
+	assertValueJSON(t, ValueFrom([]byte("hi")), `"aGk="`)
+	assertValueJSON(t, NewValue(int64(1), false), "null")
+
+	var v Value[int8]
+	if err := json.Unmarshal([]byte("300"), &v); err == nil {
+		t.Error("expected overflow error")
+	}
+	if err := json.Unmarshal([]byte(`"1"`), &v); err == nil {
+		t.Error("expected wrong type error")
+	}
+	v = ValueFrom(int8(1))
+	if err := json.Unmarshal(nullJSON, &v); err != nil || v.Valid || v.V != 0 {
+		t.Errorf("UnmarshalJSON(null) got = %v, %v", v, err)
+	}
+}
+
+func assertValueJSON[T any](t *testing.T, v Value[T], want string) {
+	t.Helper()
+	data, err := json.Marshal(v)
+	maybePanic(err)
+	assertJSONEquals(t, data, want, "Value json")
+
+	var got Value[T]
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Fatalf("UnmarshalJSON(%s) error = %v", data, err)
+	}
+	if got.Valid != v.Valid {
+		t.Errorf("UnmarshalJSON(%s) got valid = %v, want %v", data, got.Valid, v.Valid)
+	}
+}
+
+func TestValueText(t *testing.T) {
+	tests := []struct {
+		name string
+		v    interface {
+			MarshalText() ([]byte, error)
+		}
+		into interface {
+			UnmarshalText([]byte) error
+			MarshalText() ([]byte, error)
+		}
+		want string
+	}{
+		{"int64", ValueFrom(int64(-7)), &Value[int64]{}, "-7"},
+		{"uint16", ValueFrom(uint16(65535)), &Value[uint16]{}, "65535"},
+		{"float32", ValueFrom(float32(1.5)), &Value[float32]{}, "1.5"},
+		{"bool", ValueFrom(false), &Value[bool]{}, "false"},
+		{"string", ValueFrom("a b"), &Value[string]{}, "a b"},
+		{"bytes", ValueFrom([]byte("raw")), &Value[[]byte]{}, "raw"},
+		{"named", ValueFrom(currency("EUR")), &Value[currency]{}, "EUR"},
+		{"time", ValueFrom(timeValue), &Value[time.Time]{}, timeString},
+		{"null", NewValue(1, false), &Value[int]{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.v.MarshalText()
+			if err != nil || string(text) != tt.want {
+				t.Fatalf("MarshalText() got = %q, %v, want %q", text, err, tt.want)
+			}
+			if err := tt.into.UnmarshalText(text); err != nil {
+				t.Fatalf("UnmarshalText() error = %v", err)
+			}
+			if back, _ := tt.into.MarshalText(); string(back) != tt.want {
+				t.Errorf("round trip got = %q, want %q", back, tt.want)
+			}
+		})
+	}
+
+	var v Value[int8]
+	if err := v.UnmarshalText([]byte("128")); err == nil || v.Valid {
+		t.Errorf("UnmarshalText() of overflow got = %v, %v", v, err)
+	}
+	var unsupported Value[struct{ A int }]
+	unsupported.SetValid(struct{ A int }{1})
+	if _, err := unsupported.MarshalText(); err == nil {
+		t.Error("MarshalText() of a struct should fail")
+	}
+}
+
+func TestValueScanValue(t *testing.T) {
+	var i Value[int32]
+	if err := i.Scan(int64(42)); err != nil || i != ValueFrom(int32(42)) {
+		t.Errorf("Scan(int64) got = %v, %v", i, err)
+	}
+	if v, err := i.Value(); v != int64(42) || err != nil {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+	if err := i.Scan(int64(1) << 40); err == nil || i.Valid {
+		t.Errorf("Scan() of overflow got = %v, %v", i, err)
+	}
+
+	var c Value[currency]
+	if err := c.Scan([]byte("EUR")); err != nil || c != ValueFrom(currency("EUR")) {
+		t.Errorf("Scan([]byte) got = %v, %v", c, err)
+	}
+	if v, err := c.Value(); v != "EUR" || err != nil {
+		t.Errorf("Value() got = %v, %v", v, err)
+	}
+
+	var ti Value[time.Time]
+	if err := ti.Scan(timeValue); err != nil || !ti.V.Equal(timeValue) || !ti.Valid {
+		t.Errorf("Scan(time) got = %v, %v", ti, err)
+	}
+
+	// T implementing sql.Scanner and driver.Valuer is delegated to.
+	var cd Value[CardDate]
+	if err := cd.Scan("09/25"); err != nil || !cd.Valid || cd.V.String() != "09/25" {
+		t.Errorf("Scan(Scanner) got = %v, %v", cd, err)
+	}
+	if v, err := cd.Value(); err != nil || !v.(time.Time).Equal(cd.V.Time) {
+		t.Errorf("Value(Valuer) got = %v, %v", v, err)
+	}
+
+	if err := i.Scan(nil); err != nil || i.Valid {
+		t.Errorf("Scan(nil) got = %v, %v", i, err)
+	}
+	if v, err := i.Value(); v != nil || err != nil {
+		t.Errorf("Value() of null got = %v, %v", v, err)
+	}
+}
+
+func TestValueConversions(t *testing.T) {
+	if v := ValueOf[int64](Int64From(7)); v != ValueFrom(int64(7)) {
+		t.Errorf("ValueOf(Int64) got = %v", v)
+	}
+	if v := ValueOf[string](NewString("", false)); v.Valid {
+		t.Errorf("ValueOf(null String) got = %v", v)
+	}
+	if v := ValueOf[time.Time](TimeFrom(timeValue)); !v.Valid || !v.V.Equal(timeValue) {
+		t.Errorf("ValueOf(Time) got = %v", v)
+	}
+	if i := Int64FromPtr(ValueFrom(int64(7)).Ptr()); i != Int64From(7) {
+		t.Errorf("Int64FromPtr(Value.Ptr()) got = %v", i)
+	}
+	if u := Uint8FromPtr(NewValue(uint8(7), false).Ptr()); u.Valid {
+		t.Errorf("Uint8FromPtr(null Value.Ptr()) got = %v", u)
+	}
+}
+
+func TestValueRandomize(t *testing.T) {
+	next := func() int64 { return 5 }
+
+	var i Value[int8]
+	i.Randomize(next, "", false)
+	if !i.Valid {
+		t.Error("Randomize() should give a valid Value")
+	}
+	i.Randomize(next, "", true)
+	if i.Valid {
+		t.Error("Randomize() should give a null Value")
+	}
+
+	var s Value[currency]
+	s.Randomize(next, "", false)
+	if !s.Valid || s.V == "" {
+		t.Errorf("Randomize() of a named string got = %v", s)
+	}
+
+	var cd Value[CardDate]
+	cd.Randomize(next, "", false)
+	if !cd.Valid || !cd.V.Valid {
+		t.Errorf("Randomize() should delegate to CardDate.Randomize, got = %v", cd)
+	}
+}
+
+func TestValueIsZeroPtr(t *testing.T) {
+	v := ValueFrom(0)
+	if v.IsZero() || v.Ptr() == nil || *v.Ptr() != 0 {
+		t.Errorf("valid Value got IsZero() = %v, Ptr() = %v", v.IsZero(), v.Ptr())
+	}
+	v = ValueFromPtr[int](nil)
+	if !v.IsZero() || v.Ptr() != nil {
+		t.Errorf("null Value got IsZero() = %v, Ptr() = %v", v.IsZero(), v.Ptr())
+	}
+}